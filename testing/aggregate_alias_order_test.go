@@ -0,0 +1,50 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAggregateAliasOrderBy(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing ORDER BY and HAVING on aggregate aliases with BigQuery Emulator ===")
+
+	// Seed statuses with a tie between pending and suspended
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, status STRING)")
+	h.Exec(t, `INSERT INTO `+tableName+` (id, status) VALUES
+    (1, 'active'), (2, 'active'), (3, 'active'),
+    (4, 'suspended'), (5, 'suspended'),
+    (6, 'pending'), (7, 'pending'),
+    (8, 'deleted')`)
+	t.Log("✓ Table created and seeded")
+
+	for i, tc := range []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{
+			// Ties on c fall back to status ascending
+			name: "ORDER BY alias",
+			sql:  "SELECT status, COUNT(*) AS c FROM " + tableName + " GROUP BY status ORDER BY c DESC, status ASC",
+			want: "[[active 3] [pending 2] [suspended 2] [deleted 1]]",
+		},
+		{
+			name: "HAVING alias",
+			sql:  "SELECT status, COUNT(*) AS c FROM " + tableName + " GROUP BY status HAVING c > 1 ORDER BY c DESC, status ASC",
+			want: "[[active 3] [pending 2] [suspended 2]]",
+		},
+	} {
+		t.Logf("%d. Querying with %s...", i+2, tc.name)
+		rows := h.Query(t, tc.sql)
+		if got := fmt.Sprint(rows); got != tc.want {
+			t.Fatalf("%s returned %s, want %s", tc.name, got, tc.want)
+		}
+		t.Logf("✓ %s returned %s", tc.name, tc.want)
+	}
+
+	t.Log("=== Aggregate alias test completed successfully! ===")
+}
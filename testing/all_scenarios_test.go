@@ -0,0 +1,130 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+)
+
+// TestAllScenarios runs the ALTER TABLE scenarios as sub-tests against one
+// shared server. Each sub-test starts from an empty dataset and a users table
+// seeded with two rows.
+func TestAllScenarios(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	metadata := func(t *testing.T) *bigquery.TableMetadata {
+		t.Helper()
+		meta, err := h.Client.Dataset(h.DatasetID).Table("users").Metadata(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get metadata: %v", err)
+		}
+		return meta
+	}
+	// assertSchema compares the schema against "name TYPE" pairs.
+	assertSchema := func(t *testing.T, want ...string) {
+		t.Helper()
+		var got []string
+		for _, field := range metadata(t).Schema {
+			got = append(got, field.Name+" "+string(field.Type))
+		}
+		if strings.Join(got, ", ") != strings.Join(want, ", ") {
+			t.Fatalf("Schema is %v, want %v", got, want)
+		}
+	}
+	assertRows := func(t *testing.T, sql, want string) {
+		t.Helper()
+		if got := fmt.Sprint(h.Query(t, sql)); got != want {
+			t.Fatalf("%s returned %s, want %s", sql, got, want)
+		}
+	}
+
+	for _, sc := range []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		{
+			name: "AddColumn",
+			run: func(t *testing.T) {
+				h.Exec(t, "ALTER TABLE "+tableName+" ADD COLUMN age INT64")
+				assertSchema(t, "id INTEGER", "name STRING", "age INTEGER")
+				assertRows(t, "SELECT id, age FROM "+tableName+" ORDER BY id", "[[1 <nil>] [2 <nil>]]")
+			},
+		},
+		{
+			name: "DropColumn",
+			run: func(t *testing.T) {
+				h.Exec(t, "ALTER TABLE "+tableName+" DROP COLUMN name")
+				assertSchema(t, "id INTEGER")
+				assertRows(t, "SELECT * FROM "+tableName+" ORDER BY id", "[[1] [2]]")
+			},
+		},
+		{
+			name: "RenameColumn",
+			run: func(t *testing.T) {
+				h.Exec(t, "ALTER TABLE "+tableName+" RENAME COLUMN name TO full_name")
+				assertSchema(t, "id INTEGER", "full_name STRING")
+				assertRows(t, "SELECT full_name FROM "+tableName+" ORDER BY id", "[[Alice] [Bob]]")
+			},
+		},
+		{
+			name: "SetAndDropDefault",
+			run: func(t *testing.T) {
+				h.Exec(t, "ALTER TABLE "+tableName+" ALTER COLUMN name SET DEFAULT 'unknown'")
+				if def := metadata(t).Schema[1].DefaultValueExpression; def != "'unknown'" {
+					t.Fatalf("Column name has default %q, want %q", def, "'unknown'")
+				}
+				h.Exec(t, "INSERT INTO "+tableName+" (id) VALUES (3)")
+				assertRows(t, "SELECT name FROM "+tableName+" WHERE id = 3", "[[unknown]]")
+
+				h.Exec(t, "ALTER TABLE "+tableName+" ALTER COLUMN name DROP DEFAULT")
+				if def := metadata(t).Schema[1].DefaultValueExpression; def != "" {
+					t.Fatalf("Column name has default %q after DROP DEFAULT, want none", def)
+				}
+				h.Exec(t, "INSERT INTO "+tableName+" (id) VALUES (4)")
+				assertRows(t, "SELECT name FROM "+tableName+" WHERE id = 4", "[[<nil>]]")
+			},
+		},
+		{
+			name: "SetDataType",
+			run: func(t *testing.T) {
+				h.Exec(t, "ALTER TABLE "+tableName+" ALTER COLUMN id SET DATA TYPE NUMERIC")
+				assertSchema(t, "id NUMERIC", "name STRING")
+				assertRows(t, "SELECT CAST(id AS STRING) FROM "+tableName+" ORDER BY id", "[[1] [2]]")
+			},
+		},
+		{
+			name: "SetOptions",
+			run: func(t *testing.T) {
+				h.Exec(t, "ALTER TABLE "+tableName+" SET OPTIONS (description = 'All users')")
+				if desc := metadata(t).Description; desc != "All users" {
+					t.Fatalf("Table description is %q, want %q", desc, "All users")
+				}
+			},
+		},
+		{
+			name: "SetDefaultCollate",
+			run: func(t *testing.T) {
+				h.Exec(t, "ALTER TABLE "+tableName+" SET DEFAULT COLLATE 'und:ci'")
+				if collation := metadata(t).DefaultCollation; collation != "und:ci" {
+					t.Fatalf("Table default collation is %q, want %q", collation, "und:ci")
+				}
+				// The default only applies to columns added afterwards
+				h.Exec(t, "ALTER TABLE "+tableName+" ADD COLUMN nickname STRING")
+				h.Exec(t, "UPDATE "+tableName+" SET nickname = UPPER(name) WHERE TRUE")
+				assertRows(t, "SELECT id FROM "+tableName+" WHERE nickname = 'alice'", "[[1]]")
+			},
+		},
+	} {
+		t.Run(sc.name, func(t *testing.T) {
+			h.Reset(t)
+			h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING)")
+			h.Exec(t, "INSERT INTO "+tableName+" (id, name) VALUES (1, 'Alice'), (2, 'Bob')")
+			sc.run(t)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/bigquery"
+)
+
+// AlterTableBuilder collects ALTER TABLE operations on one table. Table and
+// column names are quoted by the builder; column types and default
+// expressions are written into the statement as given.
+type AlterTableBuilder struct {
+	dataset    string
+	table      string
+	operations []string
+}
+
+// AlterTable starts a builder for dataset.table. The table is resolved
+// against the client's project when the statements run.
+func AlterTable(dataset, table string) *AlterTableBuilder {
+	return &AlterTableBuilder{dataset: dataset, table: table}
+}
+
+// AddColumn adds a column of the given GoogleSQL type, e.g. "INT64" or
+// "ARRAY<STRING>".
+func (b *AlterTableBuilder) AddColumn(name, sqlType string) *AlterTableBuilder {
+	return b.add("ADD COLUMN " + quoteIdentifier(name) + " " + sqlType)
+}
+
+// DropColumn drops a column.
+func (b *AlterTableBuilder) DropColumn(name string) *AlterTableBuilder {
+	return b.add("DROP COLUMN " + quoteIdentifier(name))
+}
+
+// RenameColumn renames a column from oldName to newName.
+func (b *AlterTableBuilder) RenameColumn(oldName, newName string) *AlterTableBuilder {
+	return b.add("RENAME COLUMN " + quoteIdentifier(oldName) + " TO " + quoteIdentifier(newName))
+}
+
+// SetDefault sets the default value expression of a column.
+func (b *AlterTableBuilder) SetDefault(column, expr string) *AlterTableBuilder {
+	return b.add("ALTER COLUMN " + quoteIdentifier(column) + " SET DEFAULT " + expr)
+}
+
+func (b *AlterTableBuilder) add(operation string) *AlterTableBuilder {
+	b.operations = append(b.operations, operation)
+	return b
+}
+
+// SQL returns one ALTER TABLE statement per operation, in the order the
+// operations were added. Later operations may refer to columns added or
+// renamed by earlier ones.
+func (b *AlterTableBuilder) SQL() []string {
+	prefix := "ALTER TABLE " + quoteIdentifier(b.dataset+"."+b.table) + " "
+	statements := make([]string, len(b.operations))
+	for i, operation := range b.operations {
+		statements[i] = prefix + operation
+	}
+	return statements
+}
+
+// Exec runs the statements from SQL through RunMigration, so a failure is
+// reported as a *MigrationError and earlier operations stay applied.
+func (b *AlterTableBuilder) Exec(ctx context.Context, client *bigquery.Client) error {
+	return RunMigration(ctx, client, b.SQL())
+}
+
+// quoteIdentifier wraps name in backticks, escaping any backslash or backtick
+// it contains so the name cannot end the quoted identifier early.
+func quoteIdentifier(name string) string {
+	name = strings.ReplaceAll(name, `\`, `\\`)
+	name = strings.ReplaceAll(name, "`", "\\`")
+	return "`" + name + "`"
+}
@@ -85,6 +85,7 @@ CREATE TABLE ` + "`" + tableName + "`" + ` (
 		t.Fatalf("Table creation failed: %v", err)
 	}
 	t.Log("✓ Table created successfully with NOT NULL constraints")
+	AssertColumnMode(t, ctx, client, datasetID, tableID, "name", Required)
 
 	// Insert test data
 	t.Log("5. Inserting test data...")
@@ -121,6 +122,11 @@ VALUES (1, 'Alice', 'alice@example.com'), (2, 'Bob', 'bob@example.com')`
 	}
 	t.Log("✓ NOT NULL constraint dropped successfully via BigQuery client")
 
+	// Verify the column mode in the catalog
+	AssertColumnMode(t, ctx, client, datasetID, tableID, "name", Nullable)
+	AssertColumnMode(t, ctx, client, datasetID, tableID, "id", Required)
+	t.Log("✓ Column mode is NULLABLE in table metadata")
+
 	// Verify the NOT NULL constraint was dropped by inserting NULL values
 	t.Log("7. Verifying NOT NULL constraint was dropped...")
 	insertNullSQL := `
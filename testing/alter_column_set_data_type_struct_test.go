@@ -0,0 +1,68 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestAlterColumnSetDataTypeStruct(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing ALTER COLUMN SET DATA TYPE for STRUCT fields with BigQuery Emulator ===")
+
+	subfields := func() string {
+		t.Helper()
+		meta, err := h.Client.Dataset(h.DatasetID).Table("users").Metadata(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get metadata: %v", err)
+		}
+		if len(meta.Schema) < 2 {
+			t.Fatalf("Table has %d columns, want the profile STRUCT as the second", len(meta.Schema))
+		}
+		var names []string
+		for _, field := range meta.Schema[1].Schema {
+			names = append(names, field.Name+" "+string(field.Type))
+		}
+		return fmt.Sprint(names)
+	}
+
+	// Create a table with a STRUCT column
+	t.Log("1. Creating table with STRUCT column and seeding it...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, profile STRUCT<name STRING, age INT64>)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, profile) VALUES (1, STRUCT('Alice', 30))")
+	t.Log("✓ Table created and seeded")
+
+	// Extend the struct with a new trailing subfield
+	t.Log("2. Adding subfield city with SET DATA TYPE...")
+	h.Exec(t, "ALTER TABLE "+tableName+" ALTER COLUMN profile SET DATA TYPE STRUCT<name STRING, age INT64, city STRING>")
+	if got, want := subfields(), "[name STRING age INTEGER city STRING]"; got != want {
+		t.Fatalf("profile has subfields %s, want %s", got, want)
+	}
+	t.Log("✓ profile now has name, age and city")
+
+	// Existing rows read the new subfield as NULL, new rows can set it
+	t.Log("3. Verifying existing and new rows...")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, profile) VALUES (2, STRUCT('Bob', 40, 'Paris'))")
+	rows := h.Query(t, "SELECT id, profile.name, profile.age, profile.city FROM "+tableName+" ORDER BY id")
+	if got, want := fmt.Sprint(rows), "[[1 Alice 30 <nil>] [2 Bob 40 Paris]]"; got != want {
+		t.Fatalf("Rows are %s, want %s", got, want)
+	}
+	t.Log("✓ Existing row has NULL city and the new row has Paris")
+
+	// Dropping a subfield is not a widening and is rejected
+	t.Log("4. Removing subfield age with SET DATA TYPE...")
+	err := Exec(ctx, h.Client, "ALTER TABLE "+tableName+" ALTER COLUMN profile SET DATA TYPE STRUCT<name STRING, city STRING>")
+	if err == nil {
+		t.Fatal("Removing a STRUCT subfield succeeded, want an error")
+	}
+	t.Logf("  Error: %v", err)
+	if got, want := subfields(), "[name STRING age INTEGER city STRING]"; got != want {
+		t.Fatalf("profile has subfields %s after rejected ALTER, want %s", got, want)
+	}
+	t.Log("✓ Subfield removal rejected and schema unchanged")
+
+	t.Log("=== ALTER COLUMN SET DATA TYPE for STRUCT fields test completed successfully! ===")
+}
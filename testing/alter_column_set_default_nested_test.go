@@ -0,0 +1,141 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestAlterColumnSetDefaultNested(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing ALTER COLUMN SET DEFAULT with nested literals with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table with nested columns
+	t.Log("4. Creating table with ARRAY and STRUCT columns...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    tags ARRAY<STRING>,
+    address STRUCT<city STRING, zip INT64>
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Set an array default
+	t.Log("5. Executing ALTER COLUMN SET DEFAULT with an array literal...")
+	alterArraySQL := `ALTER TABLE ` + "`" + tableName + "`" + ` ALTER COLUMN ` + "`" + `tags` + "`" + ` SET DEFAULT ['new']`
+	t.Logf("Executing: %s", alterArraySQL)
+	if err := Exec(ctx, client, alterArraySQL); err != nil {
+		t.Fatalf("Failed to set array default: %v", err)
+	}
+	t.Log("✓ Array default set successfully")
+
+	// Set a struct default
+	t.Log("6. Executing ALTER COLUMN SET DEFAULT with a struct literal...")
+	alterStructSQL := `ALTER TABLE ` + "`" + tableName + "`" + ` ALTER COLUMN ` + "`" + `address` + "`" + ` SET DEFAULT STRUCT('x' AS city, 0 AS zip)`
+	t.Logf("Executing: %s", alterStructSQL)
+	if err := Exec(ctx, client, alterStructSQL); err != nil {
+		t.Fatalf("Failed to set struct default: %v", err)
+	}
+	t.Log("✓ Struct default set successfully")
+
+	// Insert omitting both nested columns
+	t.Log("7. Inserting a row without the nested columns...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id)
+VALUES (1)`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// Verify both defaults materialized
+	t.Log("8. Verifying nested defaults...")
+	querySQL := `SELECT tags, address.city, address.zip FROM ` + "`" + tableName + "`" + ` WHERE id = 1`
+	it, err := client.Query(querySQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query table: %v", err)
+	}
+	var rows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("Table has %d rows, want 1", len(rows))
+	}
+	t.Logf("  Tags: %v, City: %v, Zip: %v", rows[0][0], rows[0][1], rows[0][2])
+	tags, ok := rows[0][0].([]bigquery.Value)
+	if !ok || len(tags) != 1 || tags[0] != "new" {
+		t.Fatalf("Default tags are %v, want [new]", rows[0][0])
+	}
+	if rows[0][1] != "x" || rows[0][2] != int64(0) {
+		t.Fatalf("Default address is (%v, %v), want (x, 0)", rows[0][1], rows[0][2])
+	}
+	t.Log("✓ Array and struct defaults materialized")
+
+	t.Log("=== ALTER COLUMN SET DEFAULT with nested literals test completed successfully! ===")
+}
@@ -0,0 +1,180 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestAlterTableAddColumnOptions(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing ALTER TABLE ADD COLUMN with OPTIONS with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating initial table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Insert test data
+	t.Log("5. Inserting test data...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name)
+VALUES (1, 'Alice'), (2, 'Bob')`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// Execute ALTER TABLE ADD COLUMN with an inline description
+	t.Log("6. Executing ALTER TABLE ADD COLUMN with OPTIONS...")
+	alterSQL := `ALTER TABLE ` + "`" + tableName + "`" + ` ADD COLUMN notes STRING OPTIONS (description = 'free text')`
+	t.Logf("Executing: %s", alterSQL)
+	if err := Exec(ctx, client, alterSQL); err != nil {
+		t.Fatalf("Failed to execute ALTER TABLE: %v", err)
+	}
+	t.Log("✓ Column added successfully with OPTIONS")
+
+	// BigQuery cannot add a REQUIRED column to an existing table, even with a
+	// DEFAULT, so NOT NULL in the combined clause is rejected
+	t.Log("7. Executing ALTER TABLE ADD COLUMN with NOT NULL, DEFAULT and OPTIONS...")
+	alterRequiredSQL := `ALTER TABLE ` + "`" + tableName + "`" + ` ADD COLUMN state STRING NOT NULL DEFAULT 'new' OPTIONS (description = 'lifecycle state')`
+	t.Logf("Executing: %s", alterRequiredSQL)
+	if err := Exec(ctx, client, alterRequiredSQL); err == nil {
+		t.Fatal("Adding a NOT NULL column succeeded, want an error")
+	} else {
+		t.Logf("✓ NOT NULL column rejected: %v", err)
+	}
+
+	// DEFAULT and OPTIONS combine in the same clause
+	alterCombinedSQL := `ALTER TABLE ` + "`" + tableName + "`" + ` ADD COLUMN state STRING DEFAULT 'new' OPTIONS (description = 'lifecycle state')`
+	t.Logf("Executing: %s", alterCombinedSQL)
+	if err := Exec(ctx, client, alterCombinedSQL); err != nil {
+		t.Fatalf("Failed to execute combined ALTER TABLE: %v", err)
+	}
+	t.Log("✓ Column added successfully with DEFAULT and OPTIONS")
+
+	// Verify the new fields via metadata
+	t.Log("8. Verifying column descriptions via metadata...")
+	meta, err := client.Dataset(datasetID).Table(tableID).Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get table metadata: %v", err)
+	}
+	fields := make(map[string]*bigquery.FieldSchema)
+	for _, field := range meta.Schema {
+		t.Logf("  Column: %s, Type: %s, Description: %q", field.Name, field.Type, field.Description)
+		fields[field.Name] = field
+	}
+	notes, ok := fields["notes"]
+	if !ok {
+		t.Fatalf("Column notes not found in schema")
+	}
+	if notes.Description != "free text" {
+		t.Fatalf("Column notes has description %q, want %q", notes.Description, "free text")
+	}
+	state, ok := fields["state"]
+	if !ok {
+		t.Fatalf("Column state not found in schema")
+	}
+	if state.Description != "lifecycle state" {
+		t.Fatalf("Column state has description %q, want %q", state.Description, "lifecycle state")
+	}
+	if state.DefaultValueExpression != "'new'" {
+		t.Fatalf("Column state has default %q, want %q", state.DefaultValueExpression, "'new'")
+	}
+	AssertColumnMode(t, ctx, client, datasetID, tableID, "notes", Nullable)
+	AssertColumnMode(t, ctx, client, datasetID, tableID, "state", Nullable)
+	t.Log("✓ Column descriptions, default and mode stored in metadata")
+
+	// Insert a row omitting the defaulted column
+	t.Log("9. Inserting a row without the defaulted column...")
+	insertNewSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name, notes)
+VALUES (3, 'Charlie', 'hello')`
+	if err := Exec(ctx, client, insertNewSQL); err != nil {
+		t.Fatalf("Failed to insert new data: %v", err)
+	}
+	querySQL := `SELECT notes, state FROM ` + "`" + tableName + "`" + ` WHERE id = 3`
+	it, err := client.Query(querySQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query new row: %v", err)
+	}
+	var rows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) != 1 || rows[0][0] != "hello" || rows[0][1] != "new" {
+		t.Fatalf("New row is %v, want [hello new]", rows)
+	}
+	t.Log("✓ New row has notes and default state")
+
+	t.Log("=== ALTER TABLE ADD COLUMN with OPTIONS test completed successfully! ===")
+}
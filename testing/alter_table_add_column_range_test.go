@@ -0,0 +1,56 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAlterTableAddColumnRange(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("bookings")
+
+	t.Log("=== Testing ALTER TABLE ADD COLUMN with RANGE<DATE> with BigQuery Emulator ===")
+
+	// Create and seed the table
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id) VALUES (1)")
+	t.Log("✓ Table created and seeded")
+
+	// Add a RANGE<DATE> column
+	t.Log("2. Executing ALTER TABLE ADD COLUMN period RANGE<DATE>...")
+	h.Exec(t, "ALTER TABLE "+tableName+" ADD COLUMN period RANGE<DATE>")
+	t.Log("✓ RANGE<DATE> column added")
+
+	// Insert a bounded and a start-unbounded range
+	t.Log("3. Inserting ranges...")
+	h.Exec(t, `INSERT INTO `+tableName+` (id, period) VALUES
+    (2, RANGE(DATE '2024-01-01', DATE '2024-02-01')),
+    (3, RANGE(NULL, DATE '2024-02-01'))`)
+	t.Log("✓ Ranges inserted")
+
+	for i, tc := range []struct {
+		expr string
+		want string
+	}{
+		{expr: "CAST(RANGE_START(period) AS STRING)", want: "[<nil> 2024-01-01 <nil>]"},
+		{expr: "CAST(RANGE_END(period) AS STRING)", want: "[<nil> 2024-02-01 2024-02-01]"},
+		{expr: "RANGE_CONTAINS(period, DATE '2024-01-15')", want: "[<nil> true true]"},
+		// The end bound is exclusive
+		{expr: "RANGE_CONTAINS(period, DATE '2024-02-01')", want: "[<nil> false false]"},
+		// An unbounded start contains every earlier date
+		{expr: "RANGE_CONTAINS(period, DATE '1900-01-01')", want: "[<nil> false true]"},
+	} {
+		t.Logf("%d. Evaluating %s...", i+4, tc.expr)
+		var values []any
+		for _, row := range h.Query(t, "SELECT "+tc.expr+" FROM "+tableName+" ORDER BY id") {
+			values = append(values, row[0])
+		}
+		if got := fmt.Sprint(values); got != tc.want {
+			t.Fatalf("%s returned %s, want %s", tc.expr, got, tc.want)
+		}
+		t.Logf("✓ %s returned %s", tc.expr, tc.want)
+	}
+
+	t.Log("=== ALTER TABLE ADD COLUMN with RANGE<DATE> test completed successfully! ===")
+}
@@ -164,24 +164,26 @@ VALUES (3, 'Charlie', 25)`
 
 	// Final verification
 	t.Log("9. Final verification...")
-	it, err = client.Query(querySQL).Read(ctx)
+	rows, err := QueryRowsByName(ctx, client, querySQL)
 	if err != nil {
 		t.Fatalf("Failed to query final data: %v", err)
 	}
 
 	t.Log("Final data:")
-	for {
-		var row []bigquery.Value
-		if err := it.Next(&row); err != nil {
-			if err == iterator.Done {
-				break
+	want := []map[string]bigquery.Value{
+		{"id": int64(1), "name": "Alice", "age": nil},
+		{"id": int64(2), "name": "Bob", "age": nil},
+		{"id": int64(3), "name": "Charlie", "age": int64(25)},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("Got %d rows, want %d", len(rows), len(want))
+	}
+	for i, row := range rows {
+		t.Logf("  ID: %v, Name: %v, Age: %v", row["id"], row["name"], row["age"])
+		for column, value := range want[i] {
+			if row[column] != value {
+				t.Fatalf("Row %d has %s = %v, want %v", i, column, row[column], value)
 			}
-			t.Fatalf("Failed to read row: %v", err)
-		}
-		if len(row) >= 3 {
-			t.Logf("  ID: %v, Name: %v, Age: %v", row[0], row[1], row[2])
-		} else {
-			t.Logf("  ID: %v, Name: %v, Age: NULL", row[0], row[1])
 		}
 	}
 
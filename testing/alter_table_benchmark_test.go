@@ -0,0 +1,107 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/option"
+)
+
+func BenchmarkAlterAddDropColumn(b *testing.B) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		b.Fatalf("Failed to create BQE server: %v", err)
+	}
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		b.Fatalf("Failed to load initial data: %v", err)
+	}
+	if err := bqServer.SetProject(projectID); err != nil {
+		b.Fatalf("Failed to set project: %v", err)
+	}
+
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		b.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		b.Fatalf("Failed to create table: %v", err)
+	}
+
+	// Seed a moderate number of rows so schema copies have real data to move
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name)
+SELECT n, CONCAT('user_', CAST(n AS STRING)) FROM UNNEST(GENERATE_ARRAY(1, 1000)) AS n`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		b.Fatalf("Failed to insert data: %v", err)
+	}
+
+	table := client.Dataset(datasetID).Table(tableID)
+	baseline, err := table.Metadata(ctx)
+	if err != nil {
+		b.Fatalf("Failed to get baseline metadata: %v", err)
+	}
+
+	addSQL := `ALTER TABLE ` + "`" + tableName + "`" + ` ADD COLUMN age INT64`
+	dropSQL := `ALTER TABLE ` + "`" + tableName + "`" + ` DROP COLUMN age`
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := Exec(ctx, client, addSQL); err != nil {
+			b.Fatalf("Failed to add column: %v", err)
+		}
+		if err := Exec(ctx, client, dropSQL); err != nil {
+			b.Fatalf("Failed to drop column: %v", err)
+		}
+
+		// The schema check is not part of the measured operation
+		b.StopTimer()
+		meta, err := table.Metadata(ctx)
+		if err != nil {
+			b.Fatalf("Failed to get metadata: %v", err)
+		}
+		if len(meta.Schema) != len(baseline.Schema) {
+			b.Fatalf("Iteration %d left %d columns, want %d", i, len(meta.Schema), len(baseline.Schema))
+		}
+		for j, field := range baseline.Schema {
+			if meta.Schema[j].Name != field.Name || meta.Schema[j].Type != field.Type {
+				b.Fatalf("Iteration %d changed column %d to %s %s, want %s %s", i, j, meta.Schema[j].Name, meta.Schema[j].Type, field.Name, field.Type)
+			}
+		}
+		b.StartTimer()
+	}
+}
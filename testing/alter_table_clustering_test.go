@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+)
+
+func TestAlterTableClustering(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("events")
+	table := h.Client.Dataset(h.DatasetID).Table("events")
+
+	t.Log("=== Testing clustering changes with BigQuery Emulator ===")
+
+	clusteringFields := func() string {
+		t.Helper()
+		meta, err := table.Metadata(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get metadata: %v", err)
+		}
+		if meta.Clustering == nil {
+			return "[]"
+		}
+		return fmt.Sprint(meta.Clustering.Fields)
+	}
+
+	// Create a clustered table
+	t.Log("1. Creating clustered table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, customer STRING, region STRING, status STRING, created DATE) CLUSTER BY customer")
+	if got, want := clusteringFields(), "[customer]"; got != want {
+		t.Fatalf("Clustering fields are %s, want %s", got, want)
+	}
+	t.Log("✓ Table clustered by customer")
+
+	// Clustering is not a SET OPTIONS option and must not be silently ignored
+	t.Log("2. Changing clustering through SET OPTIONS...")
+	err := Exec(ctx, h.Client, "ALTER TABLE "+tableName+" SET OPTIONS (clustering = ['region'])")
+	if err == nil {
+		t.Fatal("SET OPTIONS (clustering = ...) succeeded, want an error")
+	}
+	if !strings.Contains(err.Error(), "clustering") {
+		t.Fatalf("SET OPTIONS error %q does not name the clustering option", err)
+	}
+	t.Logf("  Error: %v", err)
+	if got, want := clusteringFields(), "[customer]"; got != want {
+		t.Fatalf("Clustering fields are %s after rejected SET OPTIONS, want %s", got, want)
+	}
+	t.Log("✓ SET OPTIONS rejected and clustering unchanged")
+
+	// The supported path is a table update replacing the clustering spec
+	t.Log("3. Changing clustering through a table update...")
+	if _, err := table.Update(ctx, bigquery.TableMetadataToUpdate{
+		Clustering: &bigquery.Clustering{Fields: []string{"region", "status"}},
+	}, ""); err != nil {
+		t.Fatalf("Failed to update clustering: %v", err)
+	}
+	if got, want := clusteringFields(), "[region status]"; got != want {
+		t.Fatalf("Clustering fields are %s, want %s", got, want)
+	}
+	t.Log("✓ Clustering replaced with region, status")
+
+	// BigQuery allows at most four clustering columns
+	t.Log("4. Updating clustering with five columns...")
+	_, err = table.Update(ctx, bigquery.TableMetadataToUpdate{
+		Clustering: &bigquery.Clustering{Fields: []string{"id", "customer", "region", "status", "created"}},
+	}, "")
+	if err == nil {
+		t.Fatal("Clustering update with five columns succeeded, want an error")
+	}
+	if !strings.Contains(strings.ToLower(err.Error()), "too many clustering fields") {
+		t.Fatalf("Clustering update error %q does not report too many clustering fields", err)
+	}
+	t.Logf("  Error: %v", err)
+	if got, want := clusteringFields(), "[region status]"; got != want {
+		t.Fatalf("Clustering fields are %s after rejected update, want %s", got, want)
+	}
+	t.Log("✓ Over-long clustering spec rejected")
+
+	// An empty field list removes clustering
+	t.Log("5. Removing clustering with an empty field list...")
+	if _, err := table.Update(ctx, bigquery.TableMetadataToUpdate{
+		Clustering: &bigquery.Clustering{Fields: []string{}},
+	}, ""); err != nil {
+		t.Fatalf("Failed to remove clustering: %v", err)
+	}
+	if got, want := clusteringFields(), "[]"; got != want {
+		t.Fatalf("Clustering fields are %s after removal, want %s", got, want)
+	}
+	t.Log("✓ Clustering removed")
+
+	t.Log("=== Clustering change test completed successfully! ===")
+}
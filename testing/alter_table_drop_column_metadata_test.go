@@ -0,0 +1,156 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestAlterTableDropColumnMetadata(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing ALTER TABLE DROP COLUMN metadata cleanup with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table with a defaulted, described column
+	t.Log("4. Creating initial table with default and options...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING,
+    status STRING DEFAULT 'active' OPTIONS (description = 'account status')
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Drop the column
+	t.Log("5. Executing ALTER TABLE DROP COLUMN...")
+	dropSQL := `ALTER TABLE ` + "`" + tableName + "`" + ` DROP COLUMN ` + "`" + `status` + "`"
+	t.Logf("Executing: %s", dropSQL)
+	if err := Exec(ctx, client, dropSQL); err != nil {
+		t.Fatalf("Failed to drop column: %v", err)
+	}
+	t.Log("✓ Column dropped successfully")
+
+	// Re-add a plain column with the same name
+	t.Log("6. Re-adding a plain column with the same name...")
+	addSQL := `ALTER TABLE ` + "`" + tableName + "`" + ` ADD COLUMN status STRING`
+	t.Logf("Executing: %s", addSQL)
+	if err := Exec(ctx, client, addSQL); err != nil {
+		t.Fatalf("Failed to add column: %v", err)
+	}
+	t.Log("✓ Column re-added successfully")
+
+	// The re-added column must not inherit the old default or description
+	t.Log("7. Verifying no metadata leaked into the re-added column...")
+	meta, err := client.Dataset(datasetID).Table(tableID).Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get table metadata: %v", err)
+	}
+	found := false
+	for _, field := range meta.Schema {
+		if field.Name != "status" {
+			continue
+		}
+		found = true
+		if field.DefaultValueExpression != "" {
+			t.Fatalf("Re-added column has default %q, want none", field.DefaultValueExpression)
+		}
+		if field.Description != "" {
+			t.Fatalf("Re-added column has description %q, want none", field.Description)
+		}
+	}
+	if !found {
+		t.Fatal("Re-added column status not found in schema")
+	}
+	t.Log("✓ Re-added column has no default or description")
+
+	// Insert a row omitting the column
+	t.Log("8. Inserting a row without the re-added column...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name)
+VALUES (1, 'Alice')`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	querySQL := `SELECT id, status FROM ` + "`" + tableName + "`"
+	it, err := client.Query(querySQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query table: %v", err)
+	}
+	var rows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("Table has %d rows, want 1", len(rows))
+	}
+	if rows[0][1] != nil {
+		t.Fatalf("Omitted column is %v, want NULL instead of the old default", rows[0][1])
+	}
+	t.Log("✓ Omitted column is NULL, old default was cleaned up")
+
+	t.Log("=== ALTER TABLE DROP COLUMN metadata cleanup test completed successfully! ===")
+}
@@ -0,0 +1,82 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// BigQuery only lets foreign keys carry a constraint name; a primary key is
+// unnamed and dropped with DROP PRIMARY KEY. The named constraint here is
+// therefore a foreign key, with the primary key it references dropped after it.
+func TestAlterTableDropConstraint(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	usersName := h.TableName("users")
+	ordersName := h.TableName("orders")
+	constraintsSQL := "SELECT constraint_name, constraint_type FROM `" + h.ProjectID + "." + h.DatasetID +
+		".INFORMATION_SCHEMA.TABLE_CONSTRAINTS` WHERE table_name = 'orders'"
+
+	t.Log("=== Testing ALTER TABLE DROP CONSTRAINT with BigQuery Emulator ===")
+
+	// Create tables with a primary key and a named foreign key
+	t.Log("1. Creating tables with key constraints...")
+	h.Exec(t, "CREATE TABLE "+usersName+" (id INT64, name STRING)")
+	h.Exec(t, "ALTER TABLE "+usersName+" ADD PRIMARY KEY (id) NOT ENFORCED")
+	h.Exec(t, "CREATE TABLE "+ordersName+" (id INT64, user_id INT64)")
+	h.Exec(t, "ALTER TABLE "+ordersName+" ADD CONSTRAINT fk_orders_users FOREIGN KEY (user_id) REFERENCES "+usersName+"(id) NOT ENFORCED")
+	t.Log("✓ Constraints added")
+
+	// Verify the named constraint is visible
+	t.Log("2. Verifying constraint in metadata and INFORMATION_SCHEMA...")
+	meta, err := h.Client.Dataset(h.DatasetID).Table("orders").Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get metadata: %v", err)
+	}
+	if meta.TableConstraints == nil || len(meta.TableConstraints.ForeignKeys) != 1 || meta.TableConstraints.ForeignKeys[0].Name != "fk_orders_users" {
+		t.Fatalf("orders constraints are %+v, want foreign key fk_orders_users", meta.TableConstraints)
+	}
+	if got, want := fmt.Sprint(h.Query(t, constraintsSQL)), "[[fk_orders_users FOREIGN KEY]]"; got != want {
+		t.Fatalf("TABLE_CONSTRAINTS returned %s, want %s", got, want)
+	}
+	t.Log("✓ fk_orders_users is present")
+
+	// Drop the constraint by name
+	t.Log("3. Executing ALTER TABLE DROP CONSTRAINT fk_orders_users...")
+	h.Exec(t, "ALTER TABLE "+ordersName+" DROP CONSTRAINT fk_orders_users")
+	meta, err = h.Client.Dataset(h.DatasetID).Table("orders").Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get metadata: %v", err)
+	}
+	if meta.TableConstraints != nil && len(meta.TableConstraints.ForeignKeys) != 0 {
+		t.Fatalf("orders still has foreign keys %+v after DROP CONSTRAINT", meta.TableConstraints.ForeignKeys)
+	}
+	if rows := h.Query(t, constraintsSQL); len(rows) != 0 {
+		t.Fatalf("TABLE_CONSTRAINTS returned %v after DROP CONSTRAINT, want no rows", rows)
+	}
+	t.Log("✓ fk_orders_users is gone")
+
+	// Dropping a missing constraint fails unless IF EXISTS is given
+	t.Log("4. Dropping a non-existent constraint...")
+	if err := Exec(ctx, h.Client, "ALTER TABLE "+ordersName+" DROP CONSTRAINT fk_missing"); err == nil {
+		t.Fatal("DROP CONSTRAINT of a missing constraint succeeded, want an error")
+	} else {
+		t.Logf("  Error: %v", err)
+	}
+	h.Exec(t, "ALTER TABLE "+ordersName+" DROP CONSTRAINT IF EXISTS fk_missing")
+	t.Log("✓ Missing constraint errors without IF EXISTS and is a no-op with it")
+
+	// The unnamed primary key is dropped with DROP PRIMARY KEY
+	t.Log("5. Executing ALTER TABLE DROP PRIMARY KEY...")
+	h.Exec(t, "ALTER TABLE "+usersName+" DROP PRIMARY KEY")
+	meta, err = h.Client.Dataset(h.DatasetID).Table("users").Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get metadata: %v", err)
+	}
+	if meta.TableConstraints != nil && meta.TableConstraints.PrimaryKey != nil {
+		t.Fatalf("users still has primary key %+v", meta.TableConstraints.PrimaryKey)
+	}
+	t.Log("✓ Primary key dropped")
+
+	t.Log("=== ALTER TABLE DROP CONSTRAINT test completed successfully! ===")
+}
@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestAlterTableEmptyTable(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing ALTER TABLE on an empty table with BigQuery Emulator ===")
+
+	// columnNames returns the table's columns in schema order
+	columnNames := func() string {
+		t.Helper()
+		meta, err := h.Client.Dataset(h.DatasetID).Table("users").Metadata(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get metadata: %v", err)
+		}
+		var names []string
+		for _, field := range meta.Schema {
+			names = append(names, field.Name)
+		}
+		return fmt.Sprint(names)
+	}
+	assertRowCount := func(want int64) {
+		t.Helper()
+		count, err := RowCount(ctx, h.Client, h.DatasetID, "users")
+		if err != nil {
+			t.Fatalf("Failed to count rows: %v", err)
+		}
+		if count != want {
+			t.Fatalf("Table has %d rows, want %d", count, want)
+		}
+	}
+
+	// Create the table without inserting anything
+	t.Log("1. Creating empty table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING)")
+	t.Log("✓ Table created successfully")
+
+	// Add a column while the table has no rows
+	t.Log("2. Executing ALTER TABLE ADD COLUMN on the empty table...")
+	h.Exec(t, "ALTER TABLE "+tableName+" ADD COLUMN email STRING")
+	if got, want := columnNames(), "[id name email]"; got != want {
+		t.Fatalf("Columns are %s, want %s", got, want)
+	}
+	assertRowCount(0)
+	t.Log("✓ Column added and table still has 0 rows")
+
+	// The new column is usable straight away
+	t.Log("3. Inserting a row populating the new column...")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, name, email) VALUES (1, 'Alice', 'alice@example.com')")
+	if got, want := fmt.Sprint(h.Query(t, "SELECT id, email FROM "+tableName)), "[[1 alice@example.com]]"; got != want {
+		t.Fatalf("Table contains %s, want %s", got, want)
+	}
+	t.Log("✓ New column populated")
+
+	// Dropping a column from an empty table works too
+	t.Log("4. Executing ALTER TABLE DROP COLUMN on an empty table...")
+	h.Exec(t, "DELETE FROM "+tableName+" WHERE TRUE")
+	assertRowCount(0)
+	h.Exec(t, "ALTER TABLE "+tableName+" DROP COLUMN name")
+	if got, want := columnNames(), "[id email]"; got != want {
+		t.Fatalf("Columns are %s, want %s", got, want)
+	}
+	assertRowCount(0)
+	t.Log("✓ Column dropped and table still has 0 rows")
+
+	t.Log("=== ALTER TABLE on an empty table test completed successfully! ===")
+}
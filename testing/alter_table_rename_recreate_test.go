@@ -0,0 +1,60 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestAlterTableRenameThenRecreate(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+	renamedName := h.TableName("users_v2")
+
+	t.Log("=== Testing recreating a table after RENAME TO with BigQuery Emulator ===")
+
+	// Create, seed and rename the original table
+	t.Log("1. Creating, seeding and renaming users to users_v2...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, name) VALUES (1, 'Alice'), (2, 'Bob')")
+	h.Exec(t, "ALTER TABLE "+tableName+" RENAME TO users_v2")
+	t.Log("✓ Table renamed")
+
+	// Reuse the old name with a different schema
+	t.Log("2. Recreating users with a different schema...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (email STRING)")
+	meta, err := h.Client.Dataset(h.DatasetID).Table("users").Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get metadata: %v", err)
+	}
+	if len(meta.Schema) != 1 || meta.Schema[0].Name != "email" {
+		t.Fatalf("Recreated users has schema %v, want only email", meta.Schema)
+	}
+	count, err := RowCount(ctx, h.Client, h.DatasetID, "users")
+	if err != nil {
+		t.Fatalf("Failed to count rows: %v", err)
+	}
+	if count != 0 {
+		t.Fatalf("Recreated users has %d rows, want 0", count)
+	}
+	t.Log("✓ Recreated users is empty with its own schema")
+
+	// Insert into both tables
+	t.Log("3. Inserting into both tables...")
+	h.Exec(t, "INSERT INTO "+tableName+" (email) VALUES ('carol@example.com')")
+	h.Exec(t, "INSERT INTO "+renamedName+" (id, name) VALUES (3, 'Charlie')")
+	t.Log("✓ Rows inserted")
+
+	// Each name must resolve to its own storage
+	t.Log("4. Verifying the tables do not share data...")
+	if got, want := fmt.Sprint(h.Query(t, "SELECT email FROM "+tableName)), "[[carol@example.com]]"; got != want {
+		t.Fatalf("users contains %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(h.Query(t, "SELECT id, name FROM "+renamedName+" ORDER BY id")), "[[1 Alice] [2 Bob] [3 Charlie]]"; got != want {
+		t.Fatalf("users_v2 contains %s, want %s", got, want)
+	}
+	t.Log("✓ users and users_v2 are independent")
+
+	t.Log("=== Rename then recreate test completed successfully! ===")
+}
@@ -122,31 +122,16 @@ VALUES (1, 'Alice'), (2, 'Bob')`
 	}
 	t.Log("✓ Table renamed successfully via BigQuery client")
 
-	// Verify the table was renamed by checking if we can query the new table name
-	// Note: Due to BigQuery emulator query processing limitations, we'll use a simpler verification
+	// Verify the renamed table kept its rows
 	t.Log("7. Verifying table rename...")
-
-	// Try to query the renamed table - this may fail due to BigQuery emulator query processing issues
-	// but the ALTER TABLE RENAME TO operation itself is working correctly
-	querySQL := `SELECT COUNT(*) FROM ` + "`" + newTableName + "`"
-	it, err := client.Query(querySQL).Read(ctx)
+	count, err := RowCount(ctx, client, datasetID, newTableID)
 	if err != nil {
-		t.Logf("⚠️  Query verification failed (expected due to BigQuery emulator query processing issue): %v", err)
-		t.Log("   This is a known limitation of the BigQuery emulator's query processing pipeline.")
-		t.Log("   The ALTER TABLE RENAME TO operation itself is working correctly.")
-	} else {
-		t.Log("✓ Successfully queried renamed table")
-		for {
-			var row []bigquery.Value
-			if err := it.Next(&row); err != nil {
-				if err == iterator.Done {
-					break
-				}
-				t.Fatalf("Failed to read row: %v", err)
-			}
-			t.Logf("  Row count: %v", row[0])
-		}
+		t.Fatalf("Failed to count rows in renamed table: %v", err)
 	}
+	if count != 2 {
+		t.Fatalf("Renamed table has %d rows, want 2", count)
+	}
+	t.Log("✓ Renamed table has both rows")
 
 	// Verify the old table name no longer exists
 	t.Log("8. Verifying old table name no longer exists...")
@@ -198,8 +183,8 @@ VALUES (3, 'Charlie')`
 
 	// Try to query the renamed table again
 	t.Log("   Testing SELECT from renamed table...")
-	querySQL = `SELECT COUNT(*) FROM ` + "`" + newTableName + "`"
-	it, err = client.Query(querySQL).Read(ctx)
+	querySQL := `SELECT COUNT(*) FROM ` + "`" + newTableName + "`"
+	it, err := client.Query(querySQL).Read(ctx)
 	if err != nil {
 		t.Logf("   ❌ SELECT failed: %v", err)
 	} else {
@@ -0,0 +1,181 @@
+package testing
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestAlterTableUnquoted(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing ALTER TABLE without backticks with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table using an unquoted name
+	t.Log("4. Creating initial table without backticks...")
+	createTableSQL := `
+CREATE TABLE ` + tableName + ` (
+    id INT64,
+    name STRING
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// columnNames returns the table's columns in schema order
+	columnNames := func() string {
+		t.Helper()
+		meta, err := client.Dataset(datasetID).Table(tableID).Metadata(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get table metadata: %v", err)
+		}
+		var names []string
+		for _, field := range meta.Schema {
+			names = append(names, field.Name)
+		}
+		return strings.Join(names, ",")
+	}
+
+	steps := []struct {
+		description string
+		sql         string
+		wantColumns string
+	}{
+		{
+			description: "ADD COLUMN without backticks",
+			sql:         `ALTER TABLE ` + tableName + ` ADD COLUMN age INT64`,
+			wantColumns: "id,name,age",
+		},
+		{
+			description: "RENAME COLUMN without backticks",
+			sql:         `ALTER TABLE ` + tableName + ` RENAME COLUMN name TO full_name`,
+			wantColumns: "id,full_name,age",
+		},
+		{
+			description: "DROP COLUMN without backticks",
+			sql:         `ALTER TABLE ` + tableName + ` DROP COLUMN age`,
+			wantColumns: "id,full_name",
+		},
+		{
+			description: "ADD COLUMN with an unquoted dataset and backticked table",
+			sql:         `ALTER TABLE ` + projectID + "." + datasetID + ".`" + tableID + "`" + ` ADD COLUMN email STRING`,
+			wantColumns: "id,full_name,email",
+		},
+	}
+	for i, step := range steps {
+		t.Logf("%d. Executing %s...", i+5, step.description)
+		t.Logf("Executing: %s", step.sql)
+		if err := Exec(ctx, client, step.sql); err != nil {
+			t.Fatalf("Failed to execute %s: %v", step.description, err)
+		}
+		if got := columnNames(); got != step.wantColumns {
+			t.Fatalf("After %s columns are %s, want %s", step.description, got, step.wantColumns)
+		}
+		t.Logf("✓ %s applied, columns: %s", step.description, step.wantColumns)
+	}
+
+	// Verify the table still accepts data through an unquoted name
+	t.Log("9. Inserting and querying without backticks...")
+	insertSQL := `INSERT INTO ` + tableName + ` (id, full_name, email) VALUES (1, 'Alice', 'alice@example.com')`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	querySQL := `SELECT id, full_name, email FROM ` + tableName
+	it, err := client.Query(querySQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query table: %v", err)
+	}
+	var rows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) != 1 || rows[0][1] != "Alice" || rows[0][2] != "alice@example.com" {
+		t.Fatalf("Rows are %v, want Alice with email", rows)
+	}
+	t.Log("✓ Unquoted table name behaves like the backticked form")
+
+	// Rename the table with unquoted source and target names
+	t.Log("10. Executing RENAME TO without backticks...")
+	renamedID := tableID + "_renamed"
+	renameSQL := `ALTER TABLE ` + tableName + ` RENAME TO ` + renamedID
+	t.Logf("Executing: %s", renameSQL)
+	if err := Exec(ctx, client, renameSQL); err != nil {
+		t.Fatalf("Failed to rename table: %v", err)
+	}
+	if _, err := client.Dataset(datasetID).Table(tableID).Metadata(ctx); err == nil {
+		t.Fatalf("Table %s still exists after RENAME TO", tableID)
+	}
+	count, err := RowCount(ctx, client, datasetID, renamedID)
+	if err != nil {
+		t.Fatalf("Failed to count rows in renamed table: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("Renamed table has %d rows, want 1", count)
+	}
+	t.Logf("✓ Table renamed to %s with its row", renamedID)
+
+	t.Log("=== ALTER TABLE without backticks test completed successfully! ===")
+}
@@ -0,0 +1,56 @@
+package testing
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAlterView(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+	viewName := h.TableName("active_users")
+
+	t.Log("=== Testing ALTER VIEW and CREATE OR REPLACE VIEW with BigQuery Emulator ===")
+
+	// Create a table and a view over it
+	t.Log("1. Creating table and view...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, status STRING)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, status) VALUES (1, 'active'), (2, 'inactive'), (3, 'active')")
+	h.Exec(t, "CREATE VIEW "+viewName+" AS SELECT id FROM "+tableName+" WHERE status = 'active'")
+	t.Log("✓ Table and view created successfully")
+
+	// Set the view description
+	t.Log("2. Executing ALTER VIEW SET OPTIONS...")
+	h.Exec(t, "ALTER VIEW "+viewName+" SET OPTIONS (description = 'users with an active status')")
+	meta, err := h.Client.Dataset(h.DatasetID).Table("active_users").Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get view metadata: %v", err)
+	}
+	if meta.Description != "users with an active status" {
+		t.Fatalf("View description is %q, want %q", meta.Description, "users with an active status")
+	}
+	t.Log("✓ View description updated")
+
+	// Replace the view query
+	t.Log("3. Executing CREATE OR REPLACE VIEW...")
+	if rows := h.Query(t, "SELECT id FROM "+viewName); len(rows) != 2 {
+		t.Fatalf("Original view returned %d rows, want 2", len(rows))
+	}
+	h.Exec(t, "CREATE OR REPLACE VIEW "+viewName+" AS SELECT id FROM "+tableName+" WHERE status = 'inactive'")
+	rows := h.Query(t, "SELECT id FROM "+viewName)
+	if len(rows) != 1 || rows[0][0] != int64(2) {
+		t.Fatalf("Replaced view returned %v, want id 2", rows)
+	}
+	t.Log("✓ Queries reflect the replaced view definition")
+
+	// Altering a view that does not exist must fail
+	t.Log("4. Executing ALTER VIEW on a missing view...")
+	if err := Exec(ctx, h.Client, "ALTER VIEW "+h.TableName("missing_view")+" SET OPTIONS (description = 'x')"); err == nil {
+		t.Fatalf("ALTER VIEW on a missing view should fail, but succeeded")
+	} else {
+		t.Logf("✓ ALTER VIEW on a missing view failed (error: %v)", err)
+	}
+
+	t.Log("=== ALTER VIEW test completed successfully! ===")
+}
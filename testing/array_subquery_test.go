@@ -0,0 +1,58 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestArraySubquery(t *testing.T) {
+	h := NewHarness(t)
+	usersName := h.TableName("users")
+	ordersName := h.TableName("orders")
+
+	t.Log("=== Testing ARRAY() and scalar subqueries with BigQuery Emulator ===")
+
+	// Create and seed users and orders
+	t.Log("1. Creating and seeding users and orders...")
+	h.Exec(t, "CREATE TABLE "+usersName+" (id INT64, name STRING)")
+	h.Exec(t, "CREATE TABLE "+ordersName+" (id INT64, user_id INT64)")
+	h.Exec(t, "INSERT INTO "+usersName+" (id, name) VALUES (1, 'Alice'), (2, 'Bob'), (3, 'Charlie')")
+	h.Exec(t, "INSERT INTO "+ordersName+" (id, user_id) VALUES (10, 1), (11, 1), (12, 2)")
+	t.Log("✓ Tables created and seeded")
+
+	for i, tc := range []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{
+			// Charlie has no orders and gets an empty array
+			name: "correlated ARRAY() subquery",
+			sql: `
+SELECT u.id, ARRAY(SELECT o.id FROM ` + ordersName + ` o WHERE o.user_id = u.id ORDER BY o.id) AS order_ids
+FROM ` + usersName + ` u
+ORDER BY u.id`,
+			want: "[[1 [10 11]] [2 [12]] [3 []]]",
+		},
+		{
+			name: "scalar subquery",
+			sql: `
+SELECT u.id, (SELECT MAX(id) FROM ` + ordersName + `) AS max_order_id
+FROM ` + usersName + ` u
+ORDER BY u.id`,
+			want: "[[1 12] [2 12] [3 12]]",
+		},
+	} {
+		t.Logf("%d. Querying with %s...", i+2, tc.name)
+		rows := h.Query(t, tc.sql)
+		for _, row := range rows {
+			t.Logf("  %v", row)
+		}
+		if got := fmt.Sprint(rows); got != tc.want {
+			t.Fatalf("%s returned %s, want %s", tc.name, got, tc.want)
+		}
+		t.Logf("✓ %s returned the expected rows", tc.name)
+	}
+
+	t.Log("=== ARRAY() and scalar subquery test completed successfully! ===")
+}
@@ -0,0 +1,83 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/iterator"
+)
+
+// FieldMode is the BigQuery column mode as reported in table metadata.
+// The client library exposes it as the Required and Repeated flags on
+// bigquery.FieldSchema, so it is reconstructed here for assertions.
+type FieldMode string
+
+const (
+	Nullable FieldMode = "NULLABLE"
+	Required FieldMode = "REQUIRED"
+	Repeated FieldMode = "REPEATED"
+)
+
+// fieldMode returns the mode of a field schema.
+func fieldMode(field *bigquery.FieldSchema) FieldMode {
+	switch {
+	case field.Repeated:
+		return Repeated
+	case field.Required:
+		return Required
+	default:
+		return Nullable
+	}
+}
+
+// AssertColumnMode fails the test unless the column's mode in the table
+// metadata matches wantMode.
+func AssertColumnMode(t *testing.T, ctx context.Context, client *bigquery.Client, dataset, table, column string, wantMode FieldMode) {
+	t.Helper()
+
+	meta, err := client.Dataset(dataset).Table(table).Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get metadata for %s.%s: %v", dataset, table, err)
+	}
+	for _, field := range meta.Schema {
+		if field.Name != column {
+			continue
+		}
+		if got := fieldMode(field); got != wantMode {
+			t.Fatalf("Column %s.%s.%s has mode %s, want %s", dataset, table, column, got, wantMode)
+		}
+		return
+	}
+	t.Fatalf("Column %s not found in %s.%s", column, dataset, table)
+}
+
+// AssertResultSchema runs a query and fails the test unless the types of its
+// result columns, in SELECT order, are wantTypes.
+func AssertResultSchema(t *testing.T, ctx context.Context, client *bigquery.Client, sql string, wantTypes []bigquery.FieldType) {
+	t.Helper()
+
+	it, err := client.Query(sql).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query %q: %v", sql, err)
+	}
+	// The schema is filled in when the first page is fetched, even if the
+	// result has no rows
+	var row []bigquery.Value
+	if err := it.Next(&row); err != nil && err != iterator.Done {
+		t.Fatalf("Failed to read row: %v", err)
+	}
+
+	gotTypes := make([]bigquery.FieldType, len(it.Schema))
+	for i, field := range it.Schema {
+		gotTypes[i] = field.Type
+	}
+	if len(gotTypes) != len(wantTypes) {
+		t.Fatalf("Query %q has column types %v, want %v", sql, gotTypes, wantTypes)
+	}
+	for i := range wantTypes {
+		if gotTypes[i] != wantTypes[i] {
+			t.Fatalf("Query %q column %d (%s) has type %s, want %s", sql, i, it.Schema[i].Name, gotTypes[i], wantTypes[i])
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package testing
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/option"
+)
+
+func TestAssertStatement(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing ASSERT statements with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating initial table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING,
+    age INT64,
+    status STRING
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Insert test data
+	t.Log("5. Inserting test data...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name, age, status)
+VALUES
+    (1, 'Alice', 25, 'active'),
+    (2, 'Bob', 30, 'inactive'),
+    (3, 'Charlie', 35, 'active'),
+    (4, 'David', 40, 'inactive'),
+    (5, 'Eve', 28, 'active')`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// A passing assertion completes without error
+	t.Log("6. Executing an ASSERT that holds...")
+	passSQL := `ASSERT (SELECT COUNT(*) FROM ` + "`" + tableName + "`" + `) > 0 AS 'users must exist'`
+	t.Logf("Executing: %s", passSQL)
+	if err := Exec(ctx, client, passSQL); err != nil {
+		t.Fatalf("Failed to run passing ASSERT: %v", err)
+	}
+	t.Log("✓ Passing ASSERT completed successfully")
+
+	// A failing assertion errors with its message
+	t.Log("7. Executing an ASSERT that fails...")
+	failSQL := `ASSERT (SELECT COUNT(*) FROM ` + "`" + tableName + "`" + ` WHERE status = 'deleted') > 0 AS 'deleted users must exist'`
+	t.Logf("Executing: %s", failSQL)
+	err = Exec(ctx, client, failSQL)
+	if err == nil {
+		t.Fatalf("Failing ASSERT should return an error, but succeeded")
+	}
+	if !strings.Contains(err.Error(), "deleted users must exist") {
+		t.Fatalf("Failing ASSERT error %q does not include the assertion message", err)
+	}
+	t.Logf("✓ Failing ASSERT returned its message (error: %v)", err)
+
+	t.Log("=== ASSERT statements test completed successfully! ===")
+}
@@ -0,0 +1,108 @@
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestCaptureMetadata(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing CaptureMetadata with BigQuery Emulator ===")
+
+	capture := func() TableSnapshot {
+		t.Helper()
+		snapshot, err := CaptureMetadata(ctx, h.Client, h.DatasetID, "users")
+		if err != nil {
+			t.Fatalf("Failed to capture metadata: %v", err)
+		}
+		return snapshot
+	}
+
+	// Create the table and capture its starting metadata
+	t.Log("1. Creating table and capturing metadata...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64 NOT NULL, name STRING)")
+	before := capture()
+	wantBefore := TableSnapshot{
+		Columns: []ColumnSnapshot{
+			{Name: "id", Type: "INTEGER", Mode: Required},
+			{Name: "name", Type: "STRING", Mode: Nullable},
+		},
+	}
+	if !reflect.DeepEqual(before, wantBefore) {
+		t.Fatalf("Snapshot before ALTER is %+v, want %+v", before, wantBefore)
+	}
+	t.Log("✓ Initial snapshot matches")
+
+	// Change table options and add a defaulted column
+	t.Log("2. Executing SET OPTIONS and ADD COLUMN...")
+	h.Exec(t, "ALTER TABLE "+tableName+" SET OPTIONS (description = 'All users', labels = [('team', 'growth')])")
+	h.Exec(t, "ALTER TABLE "+tableName+" ADD COLUMN status STRING DEFAULT 'active' OPTIONS (description = 'Account status')")
+	after := capture()
+	wantAfter := TableSnapshot{
+		Description: "All users",
+		Labels:      map[string]string{"team": "growth"},
+		Columns: []ColumnSnapshot{
+			{Name: "id", Type: "INTEGER", Mode: Required},
+			{Name: "name", Type: "STRING", Mode: Nullable},
+			{Name: "status", Type: "STRING", Mode: Nullable, Description: "Account status", DefaultValue: "'active'"},
+		},
+	}
+	if !reflect.DeepEqual(after, wantAfter) {
+		t.Fatalf("Snapshot after ALTER is %+v, want %+v", after, wantAfter)
+	}
+	t.Log("✓ Snapshot reflects the description, label and new column")
+
+	// The snapshot serializes to stable JSON for golden files
+	t.Log("3. Serializing snapshot to JSON...")
+	got, err := json.Marshal(after)
+	if err != nil {
+		t.Fatalf("Failed to marshal snapshot: %v", err)
+	}
+	want := `{"description":"All users","labels":{"team":"growth"},"columns":[` +
+		`{"name":"id","type":"INTEGER","mode":"REQUIRED"},` +
+		`{"name":"name","type":"STRING","mode":"NULLABLE"},` +
+		`{"name":"status","type":"STRING","mode":"NULLABLE","description":"Account status","default_value":"'active'"}]}`
+	if string(got) != want {
+		t.Fatalf("Snapshot JSON is\n  %s\nwant\n  %s", got, want)
+	}
+	var decoded TableSnapshot
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal snapshot: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, after) {
+		t.Fatalf("Snapshot JSON round trip gave %+v, want %+v", decoded, after)
+	}
+	t.Log("✓ Snapshot round-trips through JSON")
+
+	// Range partitioning and clustering are captured too
+	t.Log("4. Capturing a range partitioned, clustered table...")
+	h.Exec(t, "CREATE TABLE "+h.TableName("orders")+" (customer_id INT64, region STRING) "+
+		"PARTITION BY RANGE_BUCKET(customer_id, GENERATE_ARRAY(0, 100, 10)) CLUSTER BY region")
+	orders, err := CaptureMetadata(ctx, h.Client, h.DatasetID, "orders")
+	if err != nil {
+		t.Fatalf("Failed to capture metadata: %v", err)
+	}
+	wantOrders := TableSnapshot{
+		PartitionType:  "RANGE",
+		PartitionField: "customer_id",
+		RangeStart:     0,
+		RangeEnd:       100,
+		RangeInterval:  10,
+		Clustering:     []string{"region"},
+		Columns: []ColumnSnapshot{
+			{Name: "customer_id", Type: "INTEGER", Mode: Nullable},
+			{Name: "region", Type: "STRING", Mode: Nullable},
+		},
+	}
+	if !reflect.DeepEqual(orders, wantOrders) {
+		t.Fatalf("Snapshot of orders is %+v, want %+v", orders, wantOrders)
+	}
+	t.Log("✓ Snapshot records the range partitioning and clustering")
+
+	t.Log("=== CaptureMetadata test completed successfully! ===")
+}
@@ -0,0 +1,96 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/option"
+)
+
+func TestColumnMode(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing column modes with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create table with required, nullable and repeated columns
+	t.Log("4. Creating table with required, nullable and repeated columns...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64 NOT NULL,
+    name STRING,
+    tags ARRAY<STRING>
+)`
+	job, err := client.Query(createTableSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	status, err := job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for table creation: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Table creation failed: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Verify each column mode from metadata
+	t.Log("5. Verifying column modes...")
+	AssertColumnMode(t, ctx, client, datasetID, tableID, "id", Required)
+	AssertColumnMode(t, ctx, client, datasetID, tableID, "name", Nullable)
+	AssertColumnMode(t, ctx, client, datasetID, tableID, "tags", Repeated)
+	t.Log("✓ Column modes match table definition")
+
+	t.Log("=== Column mode test completed successfully! ===")
+}
@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+)
+
+// BigQuery has no generated columns, so a view over the base table is the
+// usual way to expose a computed column.
+func TestComputedColumnView(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("people")
+	viewName := h.TableName("people_named")
+
+	t.Log("=== Testing computed columns through a view with BigQuery Emulator ===")
+
+	// Create and seed the base table
+	t.Log("1. Creating and seeding base table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, first STRING, last STRING, born DATE)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, first, last, born) VALUES (1, 'Ada', 'Lovelace', DATE '1815-12-10'), (2, 'Alan', 'Turing', DATE '1912-06-23')")
+	t.Log("✓ Base table created and seeded")
+
+	// Define the computed columns in a view
+	t.Log("2. Creating view with computed columns...")
+	h.Exec(t, `
+CREATE VIEW `+viewName+` AS
+SELECT id, first, last, CONCAT(first, ' ', last) AS full_name, EXTRACT(YEAR FROM born) AS birth_year
+FROM `+tableName)
+	t.Log("✓ View created successfully")
+
+	// The view's schema carries the inferred types of the computed columns
+	t.Log("3. Verifying view schema...")
+	meta, err := h.Client.Dataset(h.DatasetID).Table("people_named").Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get view metadata: %v", err)
+	}
+	wantTypes := map[string]bigquery.FieldType{
+		"full_name":  bigquery.StringFieldType,
+		"birth_year": bigquery.IntegerFieldType,
+	}
+	for _, field := range meta.Schema {
+		if want, ok := wantTypes[field.Name]; ok {
+			if field.Type != want {
+				t.Fatalf("Computed column %s has type %s, want %s", field.Name, field.Type, want)
+			}
+			delete(wantTypes, field.Name)
+		}
+	}
+	if len(wantTypes) != 0 {
+		t.Fatalf("View schema %v is missing computed columns %v", meta.Schema, wantTypes)
+	}
+	t.Log("✓ full_name is STRING and birth_year is INTEGER")
+
+	selectSQL := "SELECT id, full_name, birth_year FROM " + viewName + " ORDER BY id"
+	t.Log("4. Querying computed values...")
+	if got, want := fmt.Sprint(h.Query(t, selectSQL)), "[[1 Ada Lovelace 1815] [2 Alan Turing 1912]]"; got != want {
+		t.Fatalf("View returned %s, want %s", got, want)
+	}
+	t.Log("✓ Computed values match")
+
+	// Rows added to the base table show up computed in the view
+	t.Log("5. Inserting into the base table...")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, first, last, born) VALUES (3, 'Grace', 'Hopper', DATE '1906-12-09')")
+	if got, want := fmt.Sprint(h.Query(t, selectSQL)), "[[1 Ada Lovelace 1815] [2 Alan Turing 1912] [3 Grace Hopper 1906]]"; got != want {
+		t.Fatalf("View returned %s after insert, want %s", got, want)
+	}
+	t.Log("✓ New base row appears in the view with computed values")
+
+	t.Log("=== Computed column view test completed successfully! ===")
+}
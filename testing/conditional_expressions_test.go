@@ -0,0 +1,47 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConditionalExpressions(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing conditional expressions with BigQuery Emulator ===")
+
+	// Create and seed a table with NULLs
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING, age INT64, status STRING)")
+	h.Exec(t, `INSERT INTO `+tableName+` (id, name, age, status) VALUES
+    (1, 'Alice', 25, 'active'),
+    (2, NULL, 35, 'inactive'),
+    (3, 'Charlie', NULL, NULL)`)
+	t.Log("✓ Table created and seeded")
+
+	for i, tc := range []struct {
+		expr string
+		want string
+	}{
+		{expr: "COALESCE(age, 0)", want: "[25 35 0]"},
+		{expr: "IFNULL(name, 'unknown')", want: "[Alice unknown Charlie]"},
+		{expr: "NULLIF(status, 'inactive')", want: "[active <nil> <nil>]"},
+		{expr: "IF(age IS NULL, -1, age)", want: "[25 35 -1]"},
+		// A NULL age fails the WHEN condition and falls through to ELSE
+		{expr: "CASE WHEN age > 30 THEN 'senior' ELSE 'junior' END", want: "[junior senior junior]"},
+		{expr: "CASE status WHEN 'active' THEN 1 WHEN 'inactive' THEN 0 END", want: "[1 0 <nil>]"},
+	} {
+		t.Logf("%d. Evaluating %s...", i+2, tc.expr)
+		var values []any
+		for _, row := range h.Query(t, "SELECT "+tc.expr+" FROM "+tableName+" ORDER BY id") {
+			values = append(values, row[0])
+		}
+		if got := fmt.Sprint(values); got != tc.want {
+			t.Fatalf("%s returned %s, want %s", tc.expr, got, tc.want)
+		}
+		t.Logf("✓ %s returned %s", tc.expr, tc.want)
+	}
+
+	t.Log("=== Conditional expressions test completed successfully! ===")
+}
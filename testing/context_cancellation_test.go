@@ -0,0 +1,169 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+func TestContextCancellation(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing context cancellation with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` AS
+SELECT n AS id FROM UNNEST(GENERATE_ARRAY(1, 100000)) AS n`
+
+	// assertTableMissing fails the test if the aborted statement left a table behind
+	assertTableMissing := func() {
+		t.Helper()
+		if _, err := client.Dataset(datasetID).Table(tableID).Metadata(ctx); err == nil {
+			t.Fatalf("Table %s exists after an aborted CREATE TABLE", tableName)
+		}
+	}
+
+	// Run a statement with a context that is already cancelled
+	t.Log("4. Running CREATE TABLE with a cancelled context...")
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = client.Query(createTableSQL).Run(cancelledCtx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run with cancelled context returned %v, want context.Canceled", err)
+	}
+	assertTableMissing()
+	t.Logf("✓ Statement aborted with context.Canceled (error: %v)", err)
+
+	// Run a statement with a context whose deadline has passed
+	t.Log("5. Running CREATE TABLE with an expired deadline...")
+	expiredCtx, cancel := context.WithDeadline(ctx, time.Now().Add(-time.Second))
+	defer cancel()
+	_, err = client.Query(createTableSQL).Run(expiredCtx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Run with expired deadline returned %v, want context.DeadlineExceeded", err)
+	}
+	assertTableMissing()
+	t.Logf("✓ Statement aborted with context.DeadlineExceeded (error: %v)", err)
+
+	// Cancel a statement while waiting for its job. The cross join is slow
+	// enough that the deadline always fires while the job is running.
+	t.Log("6. Cancelling a statement while it is in flight...")
+	slowCreateTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` AS
+SELECT a * b AS id
+FROM UNNEST(GENERATE_ARRAY(1, 5000)) AS a
+CROSS JOIN UNNEST(GENERATE_ARRAY(1, 5000)) AS b`
+	// A fixed job ID lets the job be looked up on the server even if the
+	// deadline fires before jobs.insert returns
+	jobID := "context_cancellation_in_flight"
+	q := client.Query(slowCreateTableSQL)
+	q.JobID = jobID
+	inFlightCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer cancel()
+	job, err := q.Run(inFlightCtx)
+	if err == nil {
+		_, err = job.Wait(inFlightCtx)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("In-flight statement returned %v, want context.DeadlineExceeded", err)
+	}
+	t.Logf("  Client gave up with: %v", err)
+
+	// Wait for the server to finish with the job before checking for the
+	// table, so a job that kept running cannot create it afterwards
+	var status *bigquery.JobStatus
+	for deadline := time.Now().Add(time.Minute); time.Now().Before(deadline); time.Sleep(200 * time.Millisecond) {
+		serverJob, err := client.JobFromID(ctx, jobID)
+		if err != nil {
+			var apiErr *googleapi.Error
+			if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+				continue
+			}
+			t.Fatalf("Failed to look up job %s: %v", jobID, err)
+		}
+		if status, err = serverJob.Status(ctx); err != nil {
+			t.Fatalf("Failed to get status of job %s: %v", jobID, err)
+		}
+		if status.Done() {
+			break
+		}
+	}
+	switch {
+	case status == nil:
+		t.Logf("  Server never recorded job %s", jobID)
+	case !status.Done():
+		t.Fatalf("Job %s is still %v a minute after the client gave up", jobID, status.State)
+	case status.Err() == nil:
+		t.Fatalf("Job %s completed successfully after the client gave up, want it cancelled or failed", jobID)
+	default:
+		t.Logf("  Server ended job %s with: %v", jobID, status.Err())
+	}
+	assertTableMissing()
+	t.Log("✓ In-flight statement aborted without creating the table")
+
+	// The emulator must still serve requests after the aborted statements
+	t.Log("7. Verifying the server still accepts statements...")
+	if err := Exec(ctx, client, `SELECT 1`); err != nil {
+		t.Fatalf("Failed to run query after cancellation: %v", err)
+	}
+	t.Log("✓ Server still accepts statements")
+
+	t.Log("=== context cancellation test completed successfully! ===")
+}
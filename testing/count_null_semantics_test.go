@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCountNullSemantics(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing aggregate NULL semantics with BigQuery Emulator ===")
+
+	// Create and seed the table with NULLs and a duplicate email
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, email STRING, amount INT64)")
+	h.Exec(t, `INSERT INTO `+tableName+` (id, email, amount) VALUES
+    (1, 'a@example.com', 10), (2, 'a@example.com', NULL), (3, 'b@example.com', 20),
+    (4, NULL, 30), (5, NULL, NULL)`)
+	t.Log("✓ Table created and seeded")
+
+	t.Log("2. Evaluating aggregates...")
+	for _, tc := range []struct {
+		expr string
+		want string
+	}{
+		{expr: "COUNT(*)", want: "5"},
+		{expr: "COUNT(email)", want: "3"},
+		{expr: "COUNT(DISTINCT email)", want: "2"},
+		{expr: "COUNT(amount)", want: "3"},
+		// SUM and AVG only see the three non-NULL amounts
+		{expr: "SUM(amount)", want: "60"},
+		{expr: "AVG(amount)", want: "20"},
+		{expr: "COUNTIF(amount IS NULL)", want: "2"},
+	} {
+		rows := h.Query(t, "SELECT "+tc.expr+" FROM "+tableName)
+		if got := fmt.Sprint(rows[0][0]); got != tc.want {
+			t.Fatalf("%s returned %s, want %s", tc.expr, got, tc.want)
+		}
+		t.Logf("  %s -> %s", tc.expr, tc.want)
+	}
+
+	// Over only NULLs, COUNT is 0 but SUM and AVG are NULL
+	rows := h.Query(t, "SELECT COUNT(amount), SUM(amount), AVG(amount) FROM "+tableName+" WHERE amount IS NULL")
+	if got, want := fmt.Sprint(rows), "[[0 <nil> <nil>]]"; got != want {
+		t.Fatalf("Aggregates over only NULLs returned %s, want %s", got, want)
+	}
+	t.Log("✓ COUNT(*) counts every row and other aggregates skip NULLs")
+
+	t.Log("=== Aggregate NULL semantics test completed successfully! ===")
+}
@@ -0,0 +1,50 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCreateFunction(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+	functionName := h.TableName("add_bonus")
+
+	t.Log("=== Testing CREATE FUNCTION with BigQuery Emulator ===")
+
+	// Create and seed the table
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, score INT64)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, score) VALUES (1, 10), (2, 20)")
+	t.Log("✓ Table created and seeded")
+
+	// A temporary function only exists for the script that defines it
+	t.Log("2. Using a TEMP FUNCTION in the same script...")
+	rows := h.Query(t, `
+CREATE TEMP FUNCTION add_one(x INT64) AS (x + 1);
+SELECT id, add_one(score) FROM `+tableName+` ORDER BY id`)
+	if got, want := fmt.Sprint(rows), "[[1 11] [2 21]]"; got != want {
+		t.Fatalf("add_one returned %s, want %s", got, want)
+	}
+	t.Log("✓ TEMP FUNCTION applied to every row")
+
+	// A persistent function is stored in the dataset for later queries
+	t.Log("3. Creating a persistent function...")
+	h.Exec(t, "CREATE FUNCTION "+functionName+"(x INT64) AS (x + 100)")
+	rows = h.Query(t, "SELECT id, "+functionName+"(score) FROM "+tableName+" ORDER BY id")
+	if got, want := fmt.Sprint(rows), "[[1 110] [2 120]]"; got != want {
+		t.Fatalf("add_bonus returned %s, want %s", got, want)
+	}
+	t.Log("✓ Persistent function usable from a later query")
+
+	// CREATE OR REPLACE redefines the stored function
+	t.Log("4. Replacing the persistent function...")
+	h.Exec(t, "CREATE OR REPLACE FUNCTION "+functionName+"(x INT64) AS (x * 2)")
+	rows = h.Query(t, "SELECT id, "+functionName+"(score) FROM "+tableName+" ORDER BY id")
+	if got, want := fmt.Sprint(rows), "[[1 20] [2 40]]"; got != want {
+		t.Fatalf("Replaced add_bonus returned %s, want %s", got, want)
+	}
+	t.Log("✓ CREATE OR REPLACE FUNCTION changed the definition")
+
+	t.Log("=== CREATE FUNCTION test completed successfully! ===")
+}
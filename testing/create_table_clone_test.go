@@ -0,0 +1,204 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestCreateTableClone(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID    = "test"
+		datasetID    = "dataset1"
+		tableID      = "users"
+		cloneTableID = "users_clone"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+	cloneTableName := projectID + "." + datasetID + "." + cloneTableID
+
+	t.Log("=== Testing CREATE TABLE CLONE with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create base table
+	t.Log("4. Creating base table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING
+)`
+	job, err := client.Query(createTableSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	status, err := job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for table creation: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Table creation failed: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Insert test data
+	t.Log("5. Inserting test data...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name)
+VALUES (1, 'Alice'), (2, 'Bob')`
+	job, err = client.Query(insertSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	status, err = job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for insert: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Insert failed: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// Execute CREATE TABLE CLONE using BigQuery client
+	t.Log("6. Executing CREATE TABLE CLONE via BigQuery client...")
+	cloneSQL := `CREATE TABLE ` + "`" + cloneTableName + "`" + ` CLONE ` + "`" + tableName + "`"
+	t.Logf("Executing: %s", cloneSQL)
+	job, err = client.Query(cloneSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to execute CREATE TABLE CLONE: %v", err)
+	}
+	status, err = job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for CREATE TABLE CLONE: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("CREATE TABLE CLONE failed: %v", err)
+	}
+	t.Log("✓ Table cloned successfully via BigQuery client")
+
+	// Verify the clone's schema matches the base at clone time
+	t.Log("7. Verifying clone schema matches base schema...")
+	baseMeta, err := client.Dataset(datasetID).Table(tableID).Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get base table metadata: %v", err)
+	}
+	cloneMeta, err := client.Dataset(datasetID).Table(cloneTableID).Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get clone table metadata: %v", err)
+	}
+	if len(cloneMeta.Schema) != len(baseMeta.Schema) {
+		t.Fatalf("Clone has %d columns, want %d", len(cloneMeta.Schema), len(baseMeta.Schema))
+	}
+	for i, field := range baseMeta.Schema {
+		cloneField := cloneMeta.Schema[i]
+		if cloneField.Name != field.Name || cloneField.Type != field.Type {
+			t.Fatalf("Clone column %d is %s %s, want %s %s", i, cloneField.Name, cloneField.Type, field.Name, field.Type)
+		}
+	}
+	t.Log("✓ Clone schema matches base schema")
+
+	// Modify the clone with an INSERT
+	t.Log("8. Inserting a row into the clone...")
+	insertCloneSQL := `
+INSERT INTO ` + "`" + cloneTableName + "`" + ` (id, name)
+VALUES (3, 'Charlie')`
+	job, err = client.Query(insertCloneSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to insert into clone: %v", err)
+	}
+	status, err = job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for insert into clone: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Insert into clone failed: %v", err)
+	}
+	t.Log("✓ Row inserted into clone successfully")
+
+	// Verify the clone has the extra row and the base is unaffected
+	t.Log("9. Verifying base and clone contents...")
+	for _, tc := range []struct {
+		name    string
+		table   string
+		wantIDs []int64
+	}{
+		{name: "base", table: tableName, wantIDs: []int64{1, 2}},
+		{name: "clone", table: cloneTableName, wantIDs: []int64{1, 2, 3}},
+	} {
+		querySQL := `SELECT id, name FROM ` + "`" + tc.table + "`" + ` ORDER BY id`
+		it, err := client.Query(querySQL).Read(ctx)
+		if err != nil {
+			t.Fatalf("Failed to query %s table: %v", tc.name, err)
+		}
+
+		var gotIDs []int64
+		for {
+			var row []bigquery.Value
+			if err := it.Next(&row); err != nil {
+				if err == iterator.Done {
+					break
+				}
+				t.Fatalf("Failed to read row: %v", err)
+			}
+			t.Logf("  %s -> ID: %v, Name: %v", tc.name, row[0], row[1])
+			gotIDs = append(gotIDs, row[0].(int64))
+		}
+		if len(gotIDs) != len(tc.wantIDs) {
+			t.Fatalf("%s table has ids %v, want %v", tc.name, gotIDs, tc.wantIDs)
+		}
+		for i := range gotIDs {
+			if gotIDs[i] != tc.wantIDs[i] {
+				t.Fatalf("%s table has ids %v, want %v", tc.name, gotIDs, tc.wantIDs)
+			}
+		}
+	}
+	t.Log("✓ Base table is unaffected and clone has the extra row")
+
+	t.Log("=== CREATE TABLE CLONE test completed successfully! ===")
+}
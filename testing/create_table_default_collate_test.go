@@ -0,0 +1,66 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestCreateTableDefaultCollate(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing CREATE TABLE DEFAULT COLLATE with BigQuery Emulator ===")
+
+	// Create a table with a case-insensitive default and a binary override
+	t.Log("1. Creating table with DEFAULT COLLATE 'und:ci'...")
+	h.Exec(t, `
+CREATE TABLE `+tableName+` (
+    id INT64,
+    name STRING,
+    code STRING COLLATE ''
+)
+DEFAULT COLLATE 'und:ci'`)
+	h.Exec(t, "INSERT INTO "+tableName+" (id, name, code) VALUES (1, 'Alice', 'abc'), (2, 'Bob', 'ABC')")
+	t.Log("✓ Table created and seeded")
+
+	// Verify the collations in metadata
+	t.Log("2. Verifying collations in metadata...")
+	meta, err := h.Client.Dataset(h.DatasetID).Table("users").Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get metadata: %v", err)
+	}
+	if meta.DefaultCollation != "und:ci" {
+		t.Fatalf("Table default collation is %q, want %q", meta.DefaultCollation, "und:ci")
+	}
+	wantCollations := map[string]string{"id": "", "name": "und:ci", "code": ""}
+	for _, field := range meta.Schema {
+		t.Logf("  Column: %s, Collation: %q", field.Name, field.Collation)
+		if want := wantCollations[field.Name]; field.Collation != want {
+			t.Fatalf("Column %s has collation %q, want %q", field.Name, field.Collation, want)
+		}
+	}
+	t.Log("✓ Default collation applies to name and not to the overridden code column")
+
+	// Compare strings under each collation
+	t.Log("3. Verifying comparisons...")
+	for _, tc := range []struct {
+		where string
+		want  string
+	}{
+		{where: "name = 'ALICE'", want: "[[1]]"},
+		{where: "name = 'alice'", want: "[[1]]"},
+		{where: "code = 'abc'", want: "[[1]]"},
+		{where: "code = 'ABC'", want: "[[2]]"},
+	} {
+		rows := h.Query(t, "SELECT id FROM "+tableName+" WHERE "+tc.where+" ORDER BY id")
+		if got := fmt.Sprint(rows); got != tc.want {
+			t.Fatalf("WHERE %s returned %s, want %s", tc.where, got, tc.want)
+		}
+		t.Logf("  WHERE %s -> %s", tc.where, tc.want)
+	}
+	t.Log("✓ name compares case-insensitively and code compares case-sensitively")
+
+	t.Log("=== CREATE TABLE DEFAULT COLLATE test completed successfully! ===")
+}
@@ -0,0 +1,43 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCreateTableFunction(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+	functionName := h.TableName("recent_users")
+
+	t.Log("=== Testing CREATE TABLE FUNCTION with BigQuery Emulator ===")
+
+	// Create and seed the table
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, name) VALUES (1, 'Alice'), (2, 'Bob'), (3, 'Charlie')")
+	t.Log("✓ Table created and seeded")
+
+	// Define the table-valued function
+	t.Log("2. Creating table function...")
+	h.Exec(t, "CREATE TABLE FUNCTION "+functionName+"(min_id INT64) AS SELECT * FROM "+tableName+" WHERE id >= min_id")
+	t.Log("✓ Table function created")
+
+	for i, tc := range []struct {
+		minID int
+		want  string
+	}{
+		{minID: 2, want: "[[2 Bob] [3 Charlie]]"},
+		// A parameter above every id filters out all rows
+		{minID: 10, want: "[]"},
+	} {
+		t.Logf("%d. Querying recent_users(%d)...", i+3, tc.minID)
+		rows := h.Query(t, fmt.Sprintf("SELECT id, name FROM %s(%d) ORDER BY id", functionName, tc.minID))
+		if got := fmt.Sprint(rows); got != tc.want {
+			t.Fatalf("recent_users(%d) returned %s, want %s", tc.minID, got, tc.want)
+		}
+		t.Logf("✓ recent_users(%d) returned %s", tc.minID, tc.want)
+	}
+
+	t.Log("=== CREATE TABLE FUNCTION test completed successfully! ===")
+}
@@ -0,0 +1,58 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestCrossDatasetJoin(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	const ordersDatasetID = "dataset2"
+	usersName := h.TableName("users")
+	ordersName := "`" + h.ProjectID + "." + ordersDatasetID + ".orders`"
+
+	t.Log("=== Testing JOIN across datasets with BigQuery Emulator ===")
+
+	// Create a second dataset in the same project
+	t.Log("1. Creating second dataset...")
+	if err := h.Client.Dataset(ordersDatasetID).Create(ctx, nil); err != nil {
+		t.Fatalf("Failed to create dataset %s: %v", ordersDatasetID, err)
+	}
+	t.Log("✓ Dataset created successfully")
+
+	// Create users in the harness dataset and orders in the new one
+	t.Log("2. Creating and seeding tables in both datasets...")
+	h.Exec(t, "CREATE TABLE "+usersName+" (id INT64, name STRING)")
+	h.Exec(t, "CREATE TABLE "+ordersName+" (id INT64, user_id INT64, amount INT64)")
+	h.Exec(t, "INSERT INTO "+usersName+" (id, name) VALUES (1, 'Alice'), (2, 'Bob')")
+	h.Exec(t, "INSERT INTO "+ordersName+" (id, user_id, amount) VALUES (10, 1, 5), (11, 1, 7), (12, 2, 3)")
+	t.Log("✓ Tables created and seeded")
+
+	// Join with fully qualified three-part names
+	t.Log("3. Joining users and orders across datasets...")
+	rows := h.Query(t, `
+SELECT u.name, o.id, o.amount
+FROM `+usersName+` u
+JOIN `+ordersName+` o ON o.user_id = u.id
+ORDER BY o.id`)
+	for _, row := range rows {
+		t.Logf("  Name: %v, Order: %v, Amount: %v", row[0], row[1], row[2])
+	}
+	if got, want := fmt.Sprint(rows), "[[Alice 10 5] [Alice 11 7] [Bob 12 3]]"; got != want {
+		t.Fatalf("JOIN returned %s, want %s", got, want)
+	}
+	t.Log("✓ Cross-dataset JOIN returned the expected rows")
+
+	// A table in a dataset that does not exist is an error
+	t.Log("4. Joining against a missing dataset...")
+	missingSQL := "SELECT u.name FROM " + usersName + " u JOIN `" + h.ProjectID + ".missing_dataset.orders` o ON o.user_id = u.id"
+	if err := Exec(ctx, h.Client, missingSQL); err == nil {
+		t.Fatal("JOIN against a missing dataset succeeded, want an error")
+	} else {
+		t.Logf("✓ Missing dataset returned error: %v", err)
+	}
+
+	t.Log("=== Cross-dataset JOIN test completed successfully! ===")
+}
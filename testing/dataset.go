@@ -0,0 +1,39 @@
+package testing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+)
+
+// UniqueDataset returns a dataset ID made of prefix, the current time and a
+// random suffix, so tests running in parallel or back to back never pick the
+// same name.
+func UniqueDataset(prefix string) string {
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		panic("failed to read random bytes: " + err.Error())
+	}
+	return prefix + "_" + strconv.FormatInt(time.Now().UnixNano(), 10) + "_" + hex.EncodeToString(suffix)
+}
+
+// CreateDataset creates the dataset in the client's project and registers a
+// t.Cleanup that deletes it together with any tables left in it.
+func CreateDataset(t *testing.T, ctx context.Context, client *bigquery.Client, dataset string) {
+	t.Helper()
+
+	ds := client.Dataset(dataset)
+	if err := ds.Create(ctx, nil); err != nil {
+		t.Fatalf("Failed to create dataset %s: %v", dataset, err)
+	}
+	t.Cleanup(func() {
+		if err := ds.DeleteWithContents(context.Background()); err != nil {
+			t.Errorf("Failed to delete dataset %s: %v", dataset, err)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// The expressions below only use stored values, so no clock needs to be
+// injected for CURRENT_DATE or CURRENT_TIMESTAMP.
+func TestDateTimeFunctions(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("events")
+
+	t.Log("=== Testing date and time functions with BigQuery Emulator ===")
+
+	// Create and seed a table with temporal columns
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, d DATE, d2 DATE, ts TIMESTAMP)")
+	h.Exec(t, `INSERT INTO `+tableName+` (id, d, d2, ts) VALUES
+    (1, DATE '2024-01-31', DATE '2024-01-01', TIMESTAMP '2024-03-15 10:30:00 UTC'),
+    (2, DATE '2024-02-28', DATE '2023-02-28', TIMESTAMP '2023-12-31 23:59:59 UTC')`)
+	t.Log("✓ Table created and seeded")
+
+	for i, tc := range []struct {
+		expr string
+		want string
+	}{
+		{expr: "CAST(DATE_ADD(d, INTERVAL 1 DAY) AS STRING)", want: "[2024-02-01 2024-02-29]"},
+		{expr: "DATE_DIFF(d, d2, DAY)", want: "[30 365]"},
+		{expr: "EXTRACT(YEAR FROM ts)", want: "[2024 2023]"},
+		{expr: "FORMAT_TIMESTAMP('%Y-%m', ts)", want: "[2024-03 2023-12]"},
+	} {
+		t.Logf("%d. Evaluating %s...", i+2, tc.expr)
+		var values []any
+		for _, row := range h.Query(t, "SELECT "+tc.expr+" FROM "+tableName+" ORDER BY id") {
+			values = append(values, row[0])
+		}
+		if got := fmt.Sprint(values); got != tc.want {
+			t.Fatalf("%s returned %s, want %s", tc.expr, got, tc.want)
+		}
+		t.Logf("✓ %s returned %s", tc.expr, tc.want)
+	}
+
+	// TIMESTAMP_TRUNC returns a TIMESTAMP, which the client reads as time.Time
+	t.Log("6. Evaluating TIMESTAMP_TRUNC(ts, MONTH)...")
+	rows := h.Query(t, "SELECT TIMESTAMP_TRUNC(ts, MONTH) FROM "+tableName+" ORDER BY id")
+	want := []time.Time{
+		time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("TIMESTAMP_TRUNC returned %d rows, want %d", len(rows), len(want))
+	}
+	for i, row := range rows {
+		got, ok := row[0].(time.Time)
+		if !ok || !got.Equal(want[i]) {
+			t.Fatalf("TIMESTAMP_TRUNC row %d is %v, want %v", i, row[0], want[i])
+		}
+	}
+	t.Log("✓ TIMESTAMP_TRUNC truncated to the start of the month")
+
+	t.Log("=== Date and time functions test completed successfully! ===")
+}
@@ -0,0 +1,122 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+func TestDDLErrors(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing typed DDL errors with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating initial table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Drop a column that does not exist
+	t.Log("5. Dropping a non-existent column...")
+	dropSQL := `ALTER TABLE ` + "`" + tableName + "`" + ` DROP COLUMN ` + "`" + `missing` + "`"
+	t.Logf("Executing: %s", dropSQL)
+	err = Exec(ctx, client, dropSQL)
+	if !errors.Is(err, ErrColumnNotFound) {
+		t.Fatalf("DROP COLUMN error is %v, want ErrColumnNotFound", err)
+	}
+	t.Logf("✓ Got ErrColumnNotFound (error: %v)", err)
+
+	// Create the same table again
+	t.Log("6. Creating the table a second time...")
+	err = Exec(ctx, client, createTableSQL)
+	if !errors.Is(err, ErrTableAlreadyExists) {
+		t.Fatalf("CREATE TABLE error is %v, want ErrTableAlreadyExists", err)
+	}
+	t.Logf("✓ Got ErrTableAlreadyExists (error: %v)", err)
+
+	// Narrow a STRING column to INT64, which BigQuery does not allow
+	t.Log("7. Changing a STRING column to INT64...")
+	alterSQL := `ALTER TABLE ` + "`" + tableName + "`" + ` ALTER COLUMN ` + "`" + `name` + "`" + ` SET DATA TYPE INT64`
+	t.Logf("Executing: %s", alterSQL)
+	err = Exec(ctx, client, alterSQL)
+	if !errors.Is(err, ErrUnsupportedTypeConversion) {
+		t.Fatalf("SET DATA TYPE error is %v, want ErrUnsupportedTypeConversion", err)
+	}
+	t.Logf("✓ Got ErrUnsupportedTypeConversion (error: %v)", err)
+
+	// Typed errors must keep the underlying client error reachable
+	t.Log("8. Verifying the underlying error is preserved...")
+	var jobErr *bigquery.Error
+	var apiErr *googleapi.Error
+	if !errors.As(err, &jobErr) && !errors.As(err, &apiErr) {
+		t.Fatalf("Typed error does not wrap the underlying client error: %v", err)
+	}
+	if err := Exec(ctx, client, `SELECT 1`); err != nil {
+		t.Fatalf("Successful statement returned error: %v", err)
+	}
+	t.Log("✓ Underlying error preserved and successful statements return nil")
+
+	t.Log("=== typed DDL errors test completed successfully! ===")
+}
@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+)
+
+func TestDefaultProject(t *testing.T) {
+	const (
+		projectID      = "analytics"
+		otherProjectID = "other"
+		otherDatasetID = "shared"
+	)
+	h := NewHarness(t, WithProject(projectID))
+
+	t.Log("=== Testing default project resolution with BigQuery Emulator ===")
+
+	// Two-part names resolve against the client's project; neither the
+	// harness nor the test calls SetProject on the server
+	t.Log("1. Creating and querying a table with a two-part name...")
+	twoPartName := "`" + h.DatasetID + ".events`"
+	h.Exec(t, "CREATE TABLE "+twoPartName+" (id INT64)")
+	h.Exec(t, "INSERT INTO "+twoPartName+" (id) VALUES (1), (2)")
+	if got, want := fmt.Sprint(h.Query(t, "SELECT id FROM "+twoPartName+" ORDER BY id")), "[[1] [2]]"; got != want {
+		t.Fatalf("Two-part query returned %s, want %s", got, want)
+	}
+	t.Log("✓ Two-part name resolved without SetProject")
+
+	// The same table is reachable by its three-part name in the default project
+	t.Log("2. Querying the same table with its three-part name...")
+	threePartName := "`" + projectID + "." + h.DatasetID + ".events`"
+	if got, want := fmt.Sprint(h.Query(t, "SELECT id FROM "+threePartName+" ORDER BY id")), "[[1] [2]]"; got != want {
+		t.Fatalf("Three-part query returned %s, want %s", got, want)
+	}
+	t.Log("✓ Two-part name refers to the default project")
+
+	// A three-part name overrides the default project for one query
+	t.Log("3. Querying a table in another project with a three-part name...")
+	if err := h.Server.Load(
+		server.StructSource(
+			types.NewProject(
+				otherProjectID,
+				types.NewDataset(otherDatasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load second project: %v", err)
+	}
+	otherName := "`" + otherProjectID + "." + otherDatasetID + ".events`"
+	h.Exec(t, "CREATE TABLE "+otherName+" (id INT64)")
+	h.Exec(t, "INSERT INTO "+otherName+" (id) VALUES (100)")
+	if got, want := fmt.Sprint(h.Query(t, "SELECT id FROM "+otherName)), "[[100]]"; got != want {
+		t.Fatalf("Other project query returned %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(h.Query(t, "SELECT id FROM "+twoPartName+" ORDER BY id")), "[[1] [2]]"; got != want {
+		t.Fatalf("Two-part query returned %s after using another project, want %s", got, want)
+	}
+	t.Log("✓ Three-part name reached the other project and the default is unchanged")
+
+	t.Log("=== Default project test completed successfully! ===")
+}
@@ -0,0 +1,144 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+)
+
+func TestDiffSchemas(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	table := h.ProjectID + "." + h.DatasetID + ".users"
+
+	t.Log("=== Testing DiffSchemas with BigQuery Emulator ===")
+
+	// Create the table in its current shape
+	t.Log("1. Creating table with the current schema...")
+	h.Exec(t, "CREATE TABLE "+h.TableName("users")+" (id INT64 NOT NULL, name STRING, legacy STRING)")
+	meta, err := h.Client.Dataset(h.DatasetID).Table("users").Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get table metadata: %v", err)
+	}
+	current := meta.Schema
+	t.Log("✓ Table created successfully")
+
+	// Diff against the desired schema
+	t.Log("2. Diffing against the desired schema...")
+	desired := bigquery.Schema{
+		{Name: "id", Type: bigquery.NumericFieldType},
+		{Name: "name", Type: bigquery.StringFieldType},
+		{Name: "age", Type: bigquery.IntegerFieldType},
+	}
+	statements, err := DiffSchemas(table, current, desired)
+	if err != nil {
+		t.Fatalf("Failed to diff schemas: %v", err)
+	}
+	for _, statement := range statements {
+		t.Logf("  %s", statement)
+	}
+	want := []string{
+		"ALTER TABLE `" + table + "` ALTER COLUMN `id` SET DATA TYPE NUMERIC",
+		"ALTER TABLE `" + table + "` ALTER COLUMN `id` DROP NOT NULL",
+		"ALTER TABLE `" + table + "` ADD COLUMN `age` INT64",
+		"ALTER TABLE `" + table + "` DROP COLUMN `legacy`",
+	}
+	if strings.Join(statements, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("DiffSchemas returned\n%s\nwant\n%s", strings.Join(statements, "\n"), strings.Join(want, "\n"))
+	}
+	t.Log("✓ DiffSchemas returned the expected statements")
+
+	// Apply the statements and confirm the table reaches the desired schema
+	t.Log("3. Applying the generated statements...")
+	if err := RunMigration(ctx, h.Client, statements); err != nil {
+		t.Fatalf("Failed to apply generated statements: %v", err)
+	}
+	meta, err = h.Client.Dataset(h.DatasetID).Table("users").Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get table metadata: %v", err)
+	}
+	describe := func(schema bigquery.Schema) string {
+		var fields []string
+		for _, field := range schema {
+			fields = append(fields, fmt.Sprintf("%s %s %s", field.Name, sqlType(field), fieldMode(field)))
+		}
+		return strings.Join(fields, ", ")
+	}
+	if got, want := describe(meta.Schema), describe(desired); got != want {
+		t.Fatalf("Table schema is %s, want %s", got, want)
+	}
+	t.Log("✓ Table reached the desired schema")
+
+	// A schema diffed against itself needs no statements
+	t.Log("4. Diffing a schema against itself...")
+	statements, err = DiffSchemas(table, meta.Schema, meta.Schema)
+	if err != nil {
+		t.Fatalf("Failed to diff schemas: %v", err)
+	}
+	if statements == nil || len(statements) != 0 {
+		t.Fatalf("No-op diff returned %#v, want an empty slice", statements)
+	}
+	t.Log("✓ No-op diff returned an empty slice")
+
+	// Changes ALTER TABLE cannot make are reported rather than dropped
+	t.Log("5. Diffing against schemas that cannot be reached with ALTER TABLE...")
+	for _, tc := range []struct {
+		name    string
+		desired bigquery.Schema
+	}{
+		{
+			name:    "existing column made NOT NULL",
+			desired: bigquery.Schema{{Name: "id", Type: bigquery.NumericFieldType, Required: true}, {Name: "name", Type: bigquery.StringFieldType}, {Name: "age", Type: bigquery.IntegerFieldType}},
+		},
+		{
+			name:    "NOT NULL column added",
+			desired: append(bigquery.Schema{{Name: "email", Type: bigquery.StringFieldType, Required: true}}, meta.Schema...),
+		},
+		{
+			name:    "column turned into an ARRAY",
+			desired: bigquery.Schema{{Name: "id", Type: bigquery.NumericFieldType}, {Name: "name", Type: bigquery.StringFieldType, Repeated: true}, {Name: "age", Type: bigquery.IntegerFieldType}},
+		},
+	} {
+		statements, err := DiffSchemas(table, meta.Schema, tc.desired)
+		if err == nil {
+			t.Fatalf("Diff with %s returned %v, want an error", tc.name, statements)
+		}
+		t.Logf("  %s: %v", tc.name, err)
+	}
+	t.Log("✓ Unsupported changes returned errors")
+
+	// Type parameters and RANGE element types are part of the column type
+	t.Log("6. Diffing parameterized and RANGE columns...")
+	paramTable := h.ProjectID + "." + h.DatasetID + ".prices"
+	statements, err = DiffSchemas(paramTable,
+		bigquery.Schema{
+			{Name: "code", Type: bigquery.StringFieldType, MaxLength: 10},
+			{Name: "price", Type: bigquery.NumericFieldType, Precision: 10, Scale: 2},
+		},
+		bigquery.Schema{
+			{Name: "code", Type: bigquery.StringFieldType, MaxLength: 20},
+			{Name: "price", Type: bigquery.NumericFieldType, Precision: 12, Scale: 2},
+			{Name: "valid", Type: bigquery.RangeFieldType, RangeElementType: &bigquery.RangeElementType{Type: bigquery.DateFieldType}},
+		},
+	)
+	if err != nil {
+		t.Fatalf("Failed to diff schemas: %v", err)
+	}
+	for _, statement := range statements {
+		t.Logf("  %s", statement)
+	}
+	want = []string{
+		"ALTER TABLE `" + paramTable + "` ALTER COLUMN `code` SET DATA TYPE STRING(20)",
+		"ALTER TABLE `" + paramTable + "` ALTER COLUMN `price` SET DATA TYPE NUMERIC(12, 2)",
+		"ALTER TABLE `" + paramTable + "` ADD COLUMN `valid` RANGE<DATE>",
+	}
+	if strings.Join(statements, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("DiffSchemas returned\n%s\nwant\n%s", strings.Join(statements, "\n"), strings.Join(want, "\n"))
+	}
+	t.Log("✓ Parameterized and RANGE types carried into the statements")
+
+	t.Log("=== DiffSchemas test completed successfully! ===")
+}
@@ -0,0 +1,155 @@
+package testing
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestDistinctAggregation(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing DISTINCT and APPROX aggregation with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating initial table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING,
+    age INT64,
+    status STRING
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Insert test data
+	t.Log("5. Inserting test data...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name, age, status)
+VALUES
+    (1, 'Alice', 25, 'active'),
+    (2, 'Bob', 30, 'inactive'),
+    (3, 'Charlie', 35, 'active'),
+    (4, 'David', 40, 'inactive'),
+    (5, 'Eve', 28, 'active')`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// Run the aggregation variants in one query
+	t.Log("6. Executing DISTINCT and APPROX aggregations...")
+	aggSQL := `
+SELECT
+    COUNT(DISTINCT status),
+    APPROX_COUNT_DISTINCT(id),
+    ARRAY_AGG(DISTINCT status),
+    STRING_AGG(name, ',' ORDER BY id)
+FROM ` + "`" + tableName + "`"
+	it, err := client.Query(aggSQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query aggregations: %v", err)
+	}
+	var rows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("Aggregation returned %d rows, want 1", len(rows))
+	}
+	row := rows[0]
+	t.Logf("  COUNT(DISTINCT): %v, APPROX_COUNT_DISTINCT: %v, ARRAY_AGG(DISTINCT): %v, STRING_AGG: %v", row[0], row[1], row[2], row[3])
+
+	if row[0] != int64(2) {
+		t.Fatalf("COUNT(DISTINCT status) is %v, want 2", row[0])
+	}
+	approx, ok := row[1].(int64)
+	if !ok || approx < 4 || approx > 6 {
+		t.Fatalf("APPROX_COUNT_DISTINCT(id) is %v, want about 5", row[1])
+	}
+	values, ok := row[2].([]bigquery.Value)
+	if !ok {
+		t.Fatalf("ARRAY_AGG(DISTINCT status) is %T, want an array", row[2])
+	}
+	var statuses []string
+	for _, v := range values {
+		statuses = append(statuses, v.(string))
+	}
+	// ARRAY_AGG without ORDER BY has no defined order
+	sort.Strings(statuses)
+	if got, want := strings.Join(statuses, ","), "active,inactive"; got != want {
+		t.Fatalf("ARRAY_AGG(DISTINCT status) is %s, want %s", got, want)
+	}
+	if want := "Alice,Bob,Charlie,David,Eve"; row[3] != want {
+		t.Fatalf("STRING_AGG(name ORDER BY id) is %v, want %s", row[3], want)
+	}
+	t.Log("✓ DISTINCT and APPROX aggregations returned expected results")
+
+	t.Log("=== DISTINCT and APPROX aggregation test completed successfully! ===")
+}
@@ -0,0 +1,60 @@
+package testing
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDropView(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+	viewName := h.TableName("active_users")
+
+	t.Log("=== Testing DROP VIEW with BigQuery Emulator ===")
+
+	// Create a table and a view over it
+	t.Log("1. Creating table and view...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, status STRING)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, status) VALUES (1, 'active'), (2, 'inactive')")
+	h.Exec(t, "CREATE VIEW "+viewName+" AS SELECT id FROM "+tableName+" WHERE status = 'active'")
+	if rows := h.Query(t, "SELECT id FROM "+viewName); len(rows) != 1 {
+		t.Fatalf("View returned %d rows, want 1", len(rows))
+	}
+	t.Log("✓ Table and view created successfully")
+
+	// DROP TABLE on a view must fail and leave the view in place
+	t.Log("2. Executing DROP TABLE on a view...")
+	if err := Exec(ctx, h.Client, "DROP TABLE "+viewName); err == nil {
+		t.Fatalf("DROP TABLE on a view should fail, but succeeded")
+	} else {
+		t.Logf("✓ DROP TABLE on a view failed (error: %v)", err)
+	}
+	h.Query(t, "SELECT id FROM "+viewName)
+
+	// Drop the view
+	t.Log("3. Executing DROP VIEW...")
+	h.Exec(t, "DROP VIEW "+viewName)
+	if _, err := h.Client.Query("SELECT id FROM " + viewName).Read(ctx); err == nil {
+		t.Fatalf("Querying a dropped view should fail, but succeeded")
+	}
+	t.Log("✓ View dropped and no longer queryable")
+
+	// DROP VIEW IF EXISTS on a missing view is a no-op
+	t.Log("4. Executing DROP VIEW IF EXISTS on the dropped view...")
+	h.Exec(t, "DROP VIEW IF EXISTS "+viewName)
+	t.Log("✓ DROP VIEW IF EXISTS succeeded as a no-op")
+
+	// DROP VIEW on a table must fail and leave the table in place
+	t.Log("5. Executing DROP VIEW on a table...")
+	if err := Exec(ctx, h.Client, "DROP VIEW "+tableName); err == nil {
+		t.Fatalf("DROP VIEW on a table should fail, but succeeded")
+	} else {
+		t.Logf("✓ DROP VIEW on a table failed (error: %v)", err)
+	}
+	if rows := h.Query(t, "SELECT id FROM "+tableName); len(rows) != 2 {
+		t.Fatalf("Table has %d rows after rejected DROP VIEW, want 2", len(rows))
+	}
+
+	t.Log("=== DROP VIEW test completed successfully! ===")
+}
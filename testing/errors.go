@@ -0,0 +1,40 @@
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+var (
+	ErrColumnNotFound            = errors.New("column not found")
+	ErrTableAlreadyExists        = errors.New("table already exists")
+	ErrUnsupportedTypeConversion = errors.New("unsupported type conversion")
+)
+
+// errorPatterns maps emulator error messages to the typed error they represent.
+// The emulator only reports failures as text, so the patterns are kept loose
+// enough to survive small wording changes.
+var errorPatterns = []struct {
+	pattern *regexp.Regexp
+	err     error
+}{
+	{regexp.MustCompile(`(?i)column\s+\S+\s+(is\s+)?not\s+found|column\s+not\s+found|unrecognized\s+name`), ErrColumnNotFound},
+	{regexp.MustCompile(`(?i)table\s+\S+\s+already\s+exists|already\s+exists:\s+table`), ErrTableAlreadyExists},
+	{regexp.MustCompile(`(?i)(unsupported|invalid|cannot)\s+(type\s+)?(conversion|convert|cast)`), ErrUnsupportedTypeConversion},
+}
+
+// classifyError wraps err with the typed error matching its message so that
+// callers can use errors.Is against the typed error and errors.As against the
+// underlying client error. Errors that match no pattern are returned as is.
+func classifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+	for _, p := range errorPatterns {
+		if p.pattern.MatchString(err.Error()) {
+			return fmt.Errorf("%w: %w", p.err, err)
+		}
+	}
+	return err
+}
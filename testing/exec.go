@@ -0,0 +1,28 @@
+package testing
+
+import (
+	"context"
+
+	"cloud.google.com/go/bigquery"
+)
+
+// Exec runs a single statement and waits for its job to finish. Failures are
+// wrapped with the matching typed error from errors.go where one applies.
+func Exec(ctx context.Context, client *bigquery.Client, sql string) error {
+	_, err := execStatus(ctx, client, sql)
+	return err
+}
+
+// execStatus is Exec that also returns the finished job's status so callers
+// can read its statistics. The status is nil if the job never completed.
+func execStatus(ctx context.Context, client *bigquery.Client, sql string) (*bigquery.JobStatus, error) {
+	job, err := client.Query(sql).Run(ctx)
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	status, err := job.Wait(ctx)
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	return status, classifyError(status.Err())
+}
@@ -0,0 +1,231 @@
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/option"
+)
+
+func TestExportData(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing EXPORT DATA with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating initial table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING
+)`
+	job, err := client.Query(createTableSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	status, err := job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for create table: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Create table failed: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Insert test data
+	t.Log("5. Inserting test data...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name)
+VALUES (1, 'Alice'), (2, 'Bob')`
+	job, err = client.Query(insertSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	status, err = job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for insert data: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Insert data failed: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// A file:// URI stands in for a GCS bucket so exports land on local disk
+	exportDir := t.TempDir()
+
+	// readExported concatenates every file written for the given prefix
+	readExported := func(prefix string) string {
+		t.Helper()
+		files, err := filepath.Glob(filepath.Join(exportDir, prefix+"*"))
+		if err != nil {
+			t.Fatalf("Failed to list exported files: %v", err)
+		}
+		if len(files) == 0 {
+			t.Fatalf("No files exported with prefix %s", prefix)
+		}
+		var b strings.Builder
+		for _, file := range files {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("Failed to read exported file %s: %v", file, err)
+			}
+			b.Write(content)
+		}
+		return b.String()
+	}
+
+	// Execute EXPORT DATA as CSV with a header row
+	t.Log("6. Executing EXPORT DATA with CSV format and header...")
+	exportCSVSQL := `
+EXPORT DATA OPTIONS (
+    uri = 'file://` + filepath.Join(exportDir, "users_csv_*.csv") + `',
+    format = 'CSV',
+    header = true
+) AS SELECT id, name FROM ` + "`" + tableName + "`" + ` ORDER BY id`
+	t.Logf("Executing: %s", exportCSVSQL)
+	job, err = client.Query(exportCSVSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to export CSV data: %v", err)
+	}
+	status, err = job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for export CSV data: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Export CSV data failed: %v", err)
+	}
+	t.Log("✓ CSV export completed successfully")
+
+	// Verify the CSV contents
+	t.Log("7. Verifying exported CSV contents...")
+	gotCSV := strings.TrimSpace(readExported("users_csv_"))
+	wantCSV := "id,name\n1,Alice\n2,Bob"
+	if gotCSV != wantCSV {
+		t.Fatalf("Exported CSV is %q, want %q", gotCSV, wantCSV)
+	}
+	t.Log("✓ Exported CSV matches query results")
+
+	// Execute EXPORT DATA as CSV without a header row
+	t.Log("8. Executing EXPORT DATA with CSV format and no header...")
+	exportNoHeaderSQL := `
+EXPORT DATA OPTIONS (
+    uri = 'file://` + filepath.Join(exportDir, "users_noheader_*.csv") + `',
+    format = 'CSV',
+    header = false
+) AS SELECT id, name FROM ` + "`" + tableName + "`" + ` ORDER BY id`
+	job, err = client.Query(exportNoHeaderSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to export CSV data without header: %v", err)
+	}
+	status, err = job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for export CSV data without header: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Export CSV data without header failed: %v", err)
+	}
+	gotNoHeader := strings.TrimSpace(readExported("users_noheader_"))
+	if wantNoHeader := "1,Alice\n2,Bob"; gotNoHeader != wantNoHeader {
+		t.Fatalf("Exported CSV is %q, want %q", gotNoHeader, wantNoHeader)
+	}
+	t.Log("✓ CSV without header matches query results")
+
+	// Execute EXPORT DATA as newline-delimited JSON
+	t.Log("9. Executing EXPORT DATA with JSON format...")
+	exportJSONSQL := `
+EXPORT DATA OPTIONS (
+    uri = 'file://` + filepath.Join(exportDir, "users_json_*.json") + `',
+    format = 'JSON'
+) AS SELECT id, name FROM ` + "`" + tableName + "`" + ` ORDER BY id`
+	t.Logf("Executing: %s", exportJSONSQL)
+	job, err = client.Query(exportJSONSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to export JSON data: %v", err)
+	}
+	status, err = job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for export JSON data: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Export JSON data failed: %v", err)
+	}
+	t.Log("✓ JSON export completed successfully")
+
+	// Verify the JSON contents
+	t.Log("10. Verifying exported JSON contents...")
+	type exportedUser struct {
+		ID   json.Number `json:"id"`
+		Name string      `json:"name"`
+	}
+	var users []exportedUser
+	for _, line := range strings.Split(strings.TrimSpace(readExported("users_json_")), "\n") {
+		var user exportedUser
+		if err := json.Unmarshal([]byte(line), &user); err != nil {
+			t.Fatalf("Failed to decode exported JSON line %q: %v", line, err)
+		}
+		t.Logf("  ID: %v, Name: %v", user.ID, user.Name)
+		users = append(users, user)
+	}
+	if len(users) != 2 || users[0].ID != "1" || users[0].Name != "Alice" || users[1].ID != "2" || users[1].Name != "Bob" {
+		t.Fatalf("Exported JSON rows are %+v, want Alice(1) and Bob(2)", users)
+	}
+	t.Log("✓ Exported JSON matches query results")
+
+	t.Log("=== EXPORT DATA test completed successfully! ===")
+}
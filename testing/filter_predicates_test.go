@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFilterPredicates(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing BETWEEN, LIKE and IN UNNEST with BigQuery Emulator ===")
+
+	// Names include literal _ and % to exercise escaped wildcards
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING, age INT64, tags ARRAY<STRING>)")
+	h.Exec(t, `INSERT INTO `+tableName+` (id, name, age, tags) VALUES
+    (1, 'Alice', 25, ['a', 'b']),
+    (2, 'Bob', 20, ['b']),
+    (3, 'Al_x', 40, ['a']),
+    (4, 'Alan', 30, []),
+    (5, '100%', 31, NULL),
+    (6, '100 percent', 19, ['c', 'a'])`)
+	t.Log("✓ Table created and seeded")
+
+	for i, tc := range []struct {
+		where string
+		want  string
+	}{
+		// BETWEEN includes both bounds
+		{where: "age BETWEEN 20 AND 30", want: "[[1] [2] [4]]"},
+		{where: "age NOT BETWEEN 20 AND 30", want: "[[3] [5] [6]]"},
+		{where: "name LIKE 'A%'", want: "[[1] [3] [4]]"},
+		{where: "name LIKE 'Al_n'", want: "[[4]]"},
+		{where: `name LIKE r'Al\_%'`, want: "[[3]]"},
+		{where: `name LIKE r'100\%'`, want: "[[5]]"},
+		{where: "name NOT LIKE '%l%'", want: "[[2] [5] [6]]"},
+		// Empty and NULL arrays contain nothing
+		{where: "'a' IN UNNEST(tags)", want: "[[1] [3] [6]]"},
+		{where: "'z' IN UNNEST(tags)", want: "[]"},
+	} {
+		t.Logf("%d. Filtering WHERE %s...", i+2, tc.where)
+		rows := h.Query(t, "SELECT id FROM "+tableName+" WHERE "+tc.where+" ORDER BY id")
+		if got := fmt.Sprint(rows); got != tc.want {
+			t.Fatalf("WHERE %s returned %s, want %s", tc.where, got, tc.want)
+		}
+		t.Logf("✓ WHERE %s returned %s", tc.where, tc.want)
+	}
+
+	t.Log("=== BETWEEN, LIKE and IN UNNEST test completed successfully! ===")
+}
@@ -0,0 +1,151 @@
+package testing
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/iterator"
+)
+
+// AssertMatchesFixture runs sql against the emulator and fails the test unless
+// the rows equal the fixture at fixturePath. The fixture is the output of
+// `bq query --format=json` against real BigQuery: a JSON array with one object
+// per row, mapping each column name to its value as a string, or null. Rows
+// are compared in order, so the query should have an ORDER BY.
+//
+// Only non-repeated STRING, INT64, FLOAT64, BOOL, NUMERIC, BIGNUMERIC, BYTES,
+// DATE and TIMESTAMP columns are supported; any other column fails the test.
+func AssertMatchesFixture(t *testing.T, ctx context.Context, client *bigquery.Client, sql, fixturePath string) {
+	t.Helper()
+
+	content, err := os.ReadFile(fixturePath)
+	if err != nil {
+		t.Fatalf("Failed to read fixture %s: %v", fixturePath, err)
+	}
+	var want []map[string]*string
+	if err := json.Unmarshal(content, &want); err != nil {
+		t.Fatalf("Failed to parse fixture %s: %v", fixturePath, err)
+	}
+
+	it, err := client.Query(sql).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query %q: %v", sql, err)
+	}
+	got := []map[string]*string{}
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		rendered, err := fixtureRow(it.Schema, row)
+		if err != nil {
+			t.Fatalf("Cannot compare %q with fixture %s: %v", sql, fixturePath, err)
+		}
+		got = append(got, rendered)
+	}
+
+	gotJSON, _ := json.Marshal(got)
+	wantJSON, _ := json.Marshal(want)
+	if string(gotJSON) != string(wantJSON) {
+		t.Fatalf("Emulator output diverges from fixture %s\n  got:  %s\n  want: %s", fixturePath, gotJSON, wantJSON)
+	}
+}
+
+// fixtureRow renders a row the way bq's JSON output does, keyed by column
+// name with every non-NULL value as a string.
+func fixtureRow(schema bigquery.Schema, row []bigquery.Value) (map[string]*string, error) {
+	rendered := make(map[string]*string, len(row))
+	for i, field := range schema {
+		if field.Repeated {
+			return nil, fmt.Errorf("column %s is repeated, which fixtures do not support", field.Name)
+		}
+		if row[i] == nil {
+			rendered[field.Name] = nil
+			continue
+		}
+		s, err := fixtureValue(field.Type, row[i])
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", field.Name, err)
+		}
+		rendered[field.Name] = &s
+	}
+	return rendered, nil
+}
+
+// fixtureValue renders a non-NULL value of the given type as BigQuery's API
+// does.
+func fixtureValue(fieldType bigquery.FieldType, value bigquery.Value) (string, error) {
+	switch v := value.(type) {
+	case string:
+		if fieldType == bigquery.StringFieldType {
+			return v, nil
+		}
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return fixtureFloat(v), nil
+	case *big.Rat:
+		// NUMERIC and BIGNUMERIC are printed without trailing zeros
+		scale := bigquery.NumericScaleDigits
+		if fieldType == bigquery.BigNumericFieldType {
+			scale = bigquery.BigNumericScaleDigits
+		}
+		s := v.FloatString(scale)
+		if strings.Contains(s, ".") {
+			s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+		}
+		return s, nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(v), nil
+	case time.Time:
+		return v.UTC().Format("2006-01-02 15:04:05.999999"), nil
+	case fmt.Stringer:
+		// civil.Date prints as YYYY-MM-DD, matching the API
+		if fieldType == bigquery.DateFieldType {
+			return v.String(), nil
+		}
+	}
+	return "", fmt.Errorf("type %s (%T) is not supported by fixtures", fieldType, value)
+}
+
+// fixtureFloat formats a FLOAT64 like BigQuery's API, which follows Java's
+// Double.toString: plain decimals between 1e-3 and 1e7, scientific notation
+// such as 1.0E10 outside that range, and always at least one fraction digit.
+func fixtureFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	}
+	if abs := math.Abs(f); f == 0 || (abs >= 1e-3 && abs < 1e7) {
+		s := strconv.FormatFloat(f, 'f', -1, 64)
+		if !strings.Contains(s, ".") {
+			s += ".0"
+		}
+		return s
+	}
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(f, 'e', -1, 64), "e")
+	if !strings.Contains(mantissa, ".") {
+		mantissa += ".0"
+	}
+	n, _ := strconv.Atoi(exponent)
+	return mantissa + "E" + strconv.Itoa(n)
+}
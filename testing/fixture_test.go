@@ -0,0 +1,73 @@
+package testing
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+)
+
+func TestAssertMatchesFixture(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing emulator output against a BigQuery fixture ===")
+
+	// Create and seed the table the fixture was captured from
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING, status STRING, age INT64)")
+	h.Exec(t, `INSERT INTO `+tableName+` (id, name, status, age) VALUES
+    (1, 'Alice', 'active', 25), (2, 'Bob', 'active', 30), (3, 'Charlie', 'active', 35),
+    (4, 'Dave', 'inactive', 40), (5, 'Eve', 'inactive', 50),
+    (6, 'Frank', 'pending', NULL)`)
+	t.Log("✓ Table created and seeded")
+
+	// Compare a GROUP BY with NULL aggregates and ordered STRING_AGG
+	t.Log("2. Comparing GROUP BY query against fixture...")
+	AssertMatchesFixture(t, ctx, h.Client, `
+SELECT
+    status,
+    COUNT(*) AS user_count,
+    SUM(age) AS total_age,
+    STRING_AGG(name, ',' ORDER BY name) AS names
+FROM `+tableName+`
+GROUP BY status
+ORDER BY status`, filepath.Join("testdata", "users_by_status.json"))
+	t.Log("✓ Emulator output matches the fixture")
+
+	// Values are rendered per column type the way bq prints them
+	t.Log("3. Rendering typed values as bq does...")
+	for _, tc := range []struct {
+		fieldType bigquery.FieldType
+		value     bigquery.Value
+		want      string
+	}{
+		{fieldType: bigquery.NumericFieldType, value: big.NewRat(1, 2), want: "0.5"},
+		{fieldType: bigquery.NumericFieldType, value: big.NewRat(3, 1), want: "3"},
+		{fieldType: bigquery.BigNumericFieldType, value: big.NewRat(-5, 4), want: "-1.25"},
+		{fieldType: bigquery.TimestampFieldType, value: time.Date(2024, 1, 2, 3, 4, 5, 123000, time.UTC), want: "2024-01-02 03:04:05.000123"},
+		{fieldType: bigquery.BytesFieldType, value: []byte("abc"), want: "YWJj"},
+		{fieldType: bigquery.FloatFieldType, value: 30.0, want: "30.0"},
+		{fieldType: bigquery.FloatFieldType, value: 1e10, want: "1.0E10"},
+		{fieldType: bigquery.FloatFieldType, value: 1.5e-5, want: "1.5E-5"},
+	} {
+		got, err := fixtureValue(tc.fieldType, tc.value)
+		if err != nil {
+			t.Fatalf("Failed to render %s %v: %v", tc.fieldType, tc.value, err)
+		}
+		if got != tc.want {
+			t.Fatalf("%s %v rendered as %q, want %q", tc.fieldType, tc.value, got, tc.want)
+		}
+		t.Logf("  %s %v -> %s", tc.fieldType, tc.value, got)
+	}
+	if _, err := fixtureValue(bigquery.GeographyFieldType, "POINT(1 2)"); err == nil {
+		t.Fatal("Rendering a GEOGRAPHY value succeeded, want an error")
+	}
+	t.Log("✓ Typed values rendered as bq prints them and unsupported types rejected")
+
+	t.Log("=== Fixture comparison test completed successfully! ===")
+}
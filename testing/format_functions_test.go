@@ -0,0 +1,50 @@
+package testing
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFormatFunctions(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing FORMAT and FORMAT_DATE with BigQuery Emulator ===")
+
+	// Create and seed the table
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, age FLOAT64)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, age) VALUES (42, 30.456)")
+	t.Log("✓ Table created and seeded")
+
+	t.Log("2. Evaluating format specifiers...")
+	for _, tc := range []struct {
+		expr string
+		want string
+	}{
+		{expr: "FORMAT('%05d', id)", want: "00042"},
+		{expr: "FORMAT('%.2f', age)", want: "30.46"},
+		{expr: "FORMAT('%-6s|', 'ab')", want: "ab    |"},
+		{expr: "FORMAT('%x', id)", want: "2a"},
+		{expr: "FORMAT_DATE('%A', DATE '2024-01-01')", want: "Monday"},
+		{expr: "FORMAT_DATE('%d %b %Y', DATE '2024-01-01')", want: "01 Jan 2024"},
+	} {
+		rows := h.Query(t, "SELECT "+tc.expr+" FROM "+tableName)
+		if got, ok := rows[0][0].(string); !ok || got != tc.want {
+			t.Fatalf("%s returned %q, want %q", tc.expr, rows[0][0], tc.want)
+		}
+		t.Logf("  %s -> %q", tc.expr, tc.want)
+	}
+	t.Log("✓ Formatted strings match exactly")
+
+	// A specifier without a matching argument is an error
+	t.Log("3. Evaluating FORMAT with too few arguments...")
+	if err := Exec(ctx, h.Client, "SELECT FORMAT('%d %d', 1)"); err == nil {
+		t.Fatal("FORMAT with too few arguments succeeded, want an error")
+	} else {
+		t.Logf("✓ FORMAT with too few arguments returned error: %v", err)
+	}
+
+	t.Log("=== FORMAT and FORMAT_DATE test completed successfully! ===")
+}
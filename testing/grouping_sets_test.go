@@ -0,0 +1,170 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestGroupingSets(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing ROLLUP, CUBE and GROUPING SETS with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating initial table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    status STRING,
+    region STRING
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Insert test data, including a real NULL region
+	t.Log("5. Inserting test data...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, status, region)
+VALUES (1, 'active', 'us'), (2, 'active', 'eu'), (3, 'inactive', 'us'), (4, 'inactive', NULL)`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// runGrouping returns the rows of a grouped query as a comparable string
+	runGrouping := func(groupBy string) string {
+		t.Helper()
+		querySQL := `
+SELECT status, region, GROUPING(status), GROUPING(region), COUNT(*)
+FROM ` + "`" + tableName + "`" + `
+GROUP BY ` + groupBy + `
+ORDER BY GROUPING(status), status, GROUPING(region), region`
+		it, err := client.Query(querySQL).Read(ctx)
+		if err != nil {
+			t.Fatalf("Failed to query GROUP BY %s: %v", groupBy, err)
+		}
+		var rows [][]bigquery.Value
+		for {
+			var row []bigquery.Value
+			if err := it.Next(&row); err != nil {
+				if err == iterator.Done {
+					break
+				}
+				t.Fatalf("Failed to read row: %v", err)
+			}
+			t.Logf("  %v", row)
+			rows = append(rows, row)
+		}
+		return fmt.Sprint(rows)
+	}
+
+	// ROLLUP adds per-status subtotals and a grand total. GROUPING() tells
+	// the real NULL region apart from the subtotal NULLs.
+	t.Log("6. Executing GROUP BY ROLLUP...")
+	wantRollup := fmt.Sprint([][]bigquery.Value{
+		{"active", "eu", int64(0), int64(0), int64(1)},
+		{"active", "us", int64(0), int64(0), int64(1)},
+		{"active", nil, int64(0), int64(1), int64(2)},
+		{"inactive", nil, int64(0), int64(0), int64(1)},
+		{"inactive", "us", int64(0), int64(0), int64(1)},
+		{"inactive", nil, int64(0), int64(1), int64(2)},
+		{nil, nil, int64(1), int64(1), int64(4)},
+	})
+	if got := runGrouping("ROLLUP(status, region)"); got != wantRollup {
+		t.Fatalf("ROLLUP returned %s, want %s", got, wantRollup)
+	}
+	t.Log("✓ ROLLUP returned subtotals and grand total")
+
+	// CUBE additionally adds per-region subtotals
+	t.Log("7. Executing GROUP BY CUBE...")
+	wantCube := fmt.Sprint([][]bigquery.Value{
+		{"active", "eu", int64(0), int64(0), int64(1)},
+		{"active", "us", int64(0), int64(0), int64(1)},
+		{"active", nil, int64(0), int64(1), int64(2)},
+		{"inactive", nil, int64(0), int64(0), int64(1)},
+		{"inactive", "us", int64(0), int64(0), int64(1)},
+		{"inactive", nil, int64(0), int64(1), int64(2)},
+		{nil, nil, int64(1), int64(0), int64(1)},
+		{nil, "eu", int64(1), int64(0), int64(1)},
+		{nil, "us", int64(1), int64(0), int64(2)},
+		{nil, nil, int64(1), int64(1), int64(4)},
+	})
+	if got := runGrouping("CUBE(status, region)"); got != wantCube {
+		t.Fatalf("CUBE returned %s, want %s", got, wantCube)
+	}
+	t.Log("✓ CUBE returned subtotals for every grouping combination")
+
+	// GROUPING SETS returns only the listed groupings
+	t.Log("8. Executing GROUP BY GROUPING SETS...")
+	wantSets := fmt.Sprint([][]bigquery.Value{
+		{"active", nil, int64(0), int64(1), int64(2)},
+		{"inactive", nil, int64(0), int64(1), int64(2)},
+		{nil, nil, int64(1), int64(0), int64(1)},
+		{nil, "eu", int64(1), int64(0), int64(1)},
+		{nil, "us", int64(1), int64(0), int64(2)},
+	})
+	if got := runGrouping("GROUPING SETS ((status), (region))"); got != wantSets {
+		t.Fatalf("GROUPING SETS returned %s, want %s", got, wantSets)
+	}
+	t.Log("✓ GROUPING SETS returned only the requested groupings")
+
+	t.Log("=== ROLLUP, CUBE and GROUPING SETS test completed successfully! ===")
+}
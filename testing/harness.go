@@ -0,0 +1,254 @@
+package testing
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+const (
+	defaultProjectID = "test"
+	defaultDatasetID = "dataset1"
+)
+
+// Harness is an emulator server with one project and dataset loaded and a
+// client pointed at it. Everything it starts is closed through t.Cleanup.
+type Harness struct {
+	ProjectID  string
+	DatasetID  string
+	Server     *server.Server
+	TestServer *server.TestServer
+	Client     *bigquery.Client
+
+	logger       *slog.Logger
+	queryTimeout time.Duration
+	requestLogs  *logBuffer
+}
+
+// HarnessOption configures a Harness created by NewHarness.
+type HarnessOption func(*Harness)
+
+// WithLogger makes the harness emit one structured event per statement run
+// through Exec or Query, with the statement, its duration, the number of rows
+// it affected and any error.
+func WithLogger(logger *slog.Logger) HarnessOption {
+	return func(h *Harness) {
+		h.logger = logger
+	}
+}
+
+// WithQueryTimeout bounds every statement run through Exec or Query, so a
+// runaway query fails the test with a deadline error instead of hanging it.
+// A zero timeout, the default, leaves statements unbounded.
+func WithQueryTimeout(timeout time.Duration) HarnessOption {
+	return func(h *Harness) {
+		h.queryTimeout = timeout
+	}
+}
+
+// WithProject makes the harness load and default to projectID instead of
+// "test". The client's project is set to it, so two-part dataset.table names
+// resolve against the project of the job that runs them; the server's
+// SetProject is never called.
+func WithProject(projectID string) HarnessOption {
+	return func(h *Harness) {
+		h.ProjectID = projectID
+	}
+}
+
+// NewHarness starts a harness for the default project and dataset.
+func NewHarness(t *testing.T, opts ...HarnessOption) *Harness {
+	t.Helper()
+	ctx := context.Background()
+
+	h := &Harness{
+		ProjectID: defaultProjectID,
+		DatasetID: defaultDatasetID,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				h.ProjectID,
+				types.NewDataset(h.DatasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+	h.Server = bqServer
+
+	h.TestServer = bqServer.TestServer()
+	t.Cleanup(h.TestServer.Close)
+
+	clientOpts := []option.ClientOption{
+		option.WithEndpoint(h.TestServer.URL),
+		option.WithoutAuthentication(),
+	}
+	if h.requestLogs != nil {
+		clientOpts = append(clientOpts, option.WithHTTPClient(&http.Client{
+			Transport: &loggingTransport{
+				base:   http.DefaultTransport,
+				logger: slog.New(slog.NewTextHandler(h.requestLogs, nil)),
+			},
+		}))
+		t.Cleanup(func() { h.requestLogs.flush(t.Failed(), t.Log) })
+	}
+	client, err := bigquery.NewClient(ctx, h.ProjectID, clientOpts...)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	h.Client = client
+
+	return h
+}
+
+// TableName returns the backticked, fully qualified name of a table in the
+// harness dataset.
+func (h *Harness) TableName(table string) string {
+	return "`" + h.ProjectID + "." + h.DatasetID + "." + table + "`"
+}
+
+// Exec runs a statement and fails the test if it returns an error.
+func (h *Harness) Exec(t *testing.T, sql string) {
+	t.Helper()
+	if err := h.exec(sql); err != nil {
+		t.Fatalf("Failed to execute %q: %v", sql, err)
+	}
+}
+
+// Query runs a query and returns all of its rows, failing the test on error.
+func (h *Harness) Query(t *testing.T, sql string) [][]bigquery.Value {
+	t.Helper()
+	rows, err := h.query(sql)
+	if err != nil {
+		t.Fatalf("Failed to query %q: %v", sql, err)
+	}
+	return rows
+}
+
+// queryContext returns the context for one statement, bounded by the
+// timeout from WithQueryTimeout if one was set.
+func (h *Harness) queryContext() (context.Context, context.CancelFunc) {
+	if h.queryTimeout > 0 {
+		return context.WithTimeout(context.Background(), h.queryTimeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+func (h *Harness) exec(sql string) error {
+	ctx, cancel := h.queryContext()
+	defer cancel()
+
+	start := time.Now()
+	status, err := execStatus(ctx, h.Client, sql)
+	var affected int64
+	if status != nil && status.Statistics != nil {
+		if stats, ok := status.Statistics.Details.(*bigquery.QueryStatistics); ok {
+			affected = stats.NumDMLAffectedRows
+		}
+	}
+	h.logStatement(sql, time.Since(start), affected, err)
+	return err
+}
+
+func (h *Harness) query(sql string) ([][]bigquery.Value, error) {
+	ctx, cancel := h.queryContext()
+	defer cancel()
+
+	start := time.Now()
+	rows, err := h.readRows(ctx, sql)
+	h.logStatement(sql, time.Since(start), int64(len(rows)), err)
+	return rows, err
+}
+
+func (h *Harness) readRows(ctx context.Context, sql string) ([][]bigquery.Value, error) {
+	it, err := h.Client.Query(sql).Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return rows, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// logStatement emits the structured event for one statement if a logger was
+// configured with WithLogger. For queries, rows is the number of rows read.
+func (h *Harness) logStatement(sql string, duration time.Duration, rows int64, err error) {
+	if h.logger == nil {
+		return
+	}
+	attrs := []any{
+		slog.String("statement", sql),
+		slog.Duration("duration", duration),
+		slog.Int64("rows", rows),
+	}
+	if err != nil {
+		h.logger.Error("statement failed", append(attrs, slog.Any("error", err))...)
+		return
+	}
+	h.logger.Info("statement executed", attrs...)
+}
+
+// TestLogger returns a logger that writes each event to t.Log, for passing
+// to WithLogger while debugging a failing test.
+func TestLogger(t *testing.T) *slog.Logger {
+	return slog.New(slog.NewTextHandler(testLogWriter{t}, nil))
+}
+
+type testLogWriter struct {
+	t *testing.T
+}
+
+func (w testLogWriter) Write(p []byte) (int, error) {
+	w.t.Helper()
+	w.t.Log(strings.TrimSuffix(string(p), "\n"))
+	return len(p), nil
+}
+
+// Reset drops every table in the harness dataset so the next sub-test starts
+// from an empty dataset. The project and dataset themselves are kept.
+func (h *Harness) Reset(t *testing.T) {
+	t.Helper()
+	ctx := context.Background()
+
+	tables := h.Client.Dataset(h.DatasetID).Tables(ctx)
+	for {
+		table, err := tables.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to list tables in %s: %v", h.DatasetID, err)
+		}
+		if err := table.Delete(ctx); err != nil {
+			t.Fatalf("Failed to delete table %s: %v", table.TableID, err)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package testing
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+)
+
+// recordingHandler keeps every slog record it receives.
+type recordingHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *recordingHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *recordingHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, r.Clone())
+	return nil
+}
+
+func (h *recordingHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+
+func (h *recordingHandler) WithGroup(string) slog.Handler { return h }
+
+func TestHarnessLogger(t *testing.T) {
+	handler := &recordingHandler{}
+	h := NewHarness(t, WithLogger(slog.New(handler)))
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing harness structured logging with BigQuery Emulator ===")
+
+	// Run a DDL, a DML and a query through the harness
+	t.Log("1. Running statements through the harness...")
+	statements := []string{
+		"CREATE TABLE " + tableName + " (id INT64, name STRING)",
+		"INSERT INTO " + tableName + " (id, name) VALUES (1, 'Alice'), (2, 'Bob')",
+		"SELECT id FROM " + tableName,
+	}
+	h.Exec(t, statements[0])
+	h.Exec(t, statements[1])
+	h.Query(t, statements[2])
+	t.Log("✓ Statements executed successfully")
+
+	// Verify one event per statement with the expected attributes
+	t.Log("2. Verifying logged events...")
+	if len(handler.records) != len(statements) {
+		t.Fatalf("Logger received %d events, want %d", len(handler.records), len(statements))
+	}
+	wantRows := []int64{0, 2, 2}
+	for i, record := range handler.records {
+		attrs := make(map[string]slog.Value)
+		record.Attrs(func(a slog.Attr) bool {
+			attrs[a.Key] = a.Value
+			return true
+		})
+		t.Logf("  %s %v", record.Message, attrs)
+		if got := attrs["statement"].String(); got != statements[i] {
+			t.Fatalf("Event %d has statement %q, want %q", i, got, statements[i])
+		}
+		if _, ok := attrs["duration"]; !ok {
+			t.Fatalf("Event %d has no duration", i)
+		}
+		if got := attrs["rows"].Int64(); got != wantRows[i] {
+			t.Fatalf("Event %d has rows %d, want %d", i, got, wantRows[i])
+		}
+		if _, ok := attrs["error"]; ok {
+			t.Fatalf("Event %d has an error attribute for a successful statement", i)
+		}
+		if record.Level != slog.LevelInfo {
+			t.Fatalf("Event %d has level %s, want %s", i, record.Level, slog.LevelInfo)
+		}
+	}
+	t.Log("✓ One event logged per statement")
+
+	// A failing statement is logged at error level with its error
+	t.Log("3. Running a failing statement through the harness...")
+	failing := "SELECT missing_column FROM " + tableName
+	if err := h.exec(failing); err == nil {
+		t.Fatal("Query of a missing column succeeded, want an error")
+	}
+	if len(handler.records) != len(statements)+1 {
+		t.Fatalf("Logger received %d events, want %d", len(handler.records), len(statements)+1)
+	}
+	record := handler.records[len(statements)]
+	attrs := make(map[string]slog.Value)
+	record.Attrs(func(a slog.Attr) bool {
+		attrs[a.Key] = a.Value
+		return true
+	})
+	t.Logf("  %s %v", record.Message, attrs)
+	if record.Level != slog.LevelError {
+		t.Fatalf("Failing statement event has level %s, want %s", record.Level, slog.LevelError)
+	}
+	if got := attrs["statement"].String(); got != failing {
+		t.Fatalf("Failing statement event has statement %q, want %q", got, failing)
+	}
+	if _, ok := attrs["error"]; !ok {
+		t.Fatal("Failing statement event has no error attribute")
+	}
+	t.Log("✓ Failing statement logged at error level with its error")
+
+	t.Log("=== Harness logging test completed successfully! ===")
+}
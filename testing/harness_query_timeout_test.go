@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHarnessQueryTimeout(t *testing.T) {
+	t.Log("=== Testing harness query timeout with BigQuery Emulator ===")
+
+	// A cross join of two large arrays stands in for a runaway query
+	slowSQL := `
+SELECT COUNT(*)
+FROM UNNEST(GENERATE_ARRAY(1, 5000)) AS a
+CROSS JOIN UNNEST(GENERATE_ARRAY(1, 5000)) AS b`
+
+	// A short timeout cuts the slow query off with a deadline error
+	t.Log("1. Running a slow query with a 10ms timeout...")
+	short := NewHarness(t, WithQueryTimeout(10*time.Millisecond))
+	start := time.Now()
+	_, err := short.query(slowSQL)
+	if err == nil {
+		t.Fatal("Slow query succeeded within 10ms, want a deadline error")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Slow query returned %v, want context.DeadlineExceeded", err)
+	}
+	t.Logf("✓ Slow query failed after %s: %v", time.Since(start), err)
+
+	// The same bound applies to Exec
+	if err := short.exec(slowSQL); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Slow statement returned %v, want context.DeadlineExceeded", err)
+	}
+	t.Log("✓ Exec is bounded by the same timeout")
+
+	// DDL and DML finish well within a generous timeout
+	t.Log("2. Running DDL with a 30s timeout...")
+	generous := NewHarness(t, WithQueryTimeout(30*time.Second))
+	tableName := generous.TableName("users")
+	generous.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING)")
+	generous.Exec(t, "ALTER TABLE "+tableName+" ADD COLUMN age INT64")
+	generous.Exec(t, "INSERT INTO "+tableName+" (id, name, age) VALUES (1, 'Alice', 30)")
+	if rows := generous.Query(t, "SELECT COUNT(*) FROM "+tableName); rows[0][0].(int64) != 1 {
+		t.Fatalf("Table has %v rows, want 1", rows[0][0])
+	}
+	t.Log("✓ DDL and DML completed within the timeout")
+
+	t.Log("=== Harness query timeout test completed successfully! ===")
+}
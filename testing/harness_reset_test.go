@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/iterator"
+)
+
+func TestHarnessReset(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+
+	// tableIDs lists the tables currently in the harness dataset
+	tableIDs := func(t *testing.T) []string {
+		t.Helper()
+		var ids []string
+		tables := h.Client.Dataset(h.DatasetID).Tables(ctx)
+		for {
+			table, err := tables.Next()
+			if err != nil {
+				if err == iterator.Done {
+					break
+				}
+				t.Fatalf("Failed to list tables: %v", err)
+			}
+			ids = append(ids, table.TableID)
+		}
+		return ids
+	}
+
+	for _, scenario := range []string{"first", "second", "third"} {
+		t.Run(scenario, func(t *testing.T) {
+			h.Reset(t)
+
+			// Every sub-test starts from an empty dataset
+			if ids := tableIDs(t); len(ids) != 0 {
+				t.Fatalf("Dataset has tables %v after Reset, want none", ids)
+			}
+
+			// Each scenario reuses the same table name with its own data
+			h.Exec(t, "CREATE TABLE "+h.TableName("users")+" (id INT64, scenario STRING)")
+			h.Exec(t, "INSERT INTO "+h.TableName("users")+" (id, scenario) VALUES (1, '"+scenario+"')")
+			h.Exec(t, "CREATE TABLE "+h.TableName("users_"+scenario)+" (id INT64)")
+
+			it, err := h.Client.Query("SELECT scenario FROM " + h.TableName("users")).Read(ctx)
+			if err != nil {
+				t.Fatalf("Failed to query table: %v", err)
+			}
+			var scenarios []string
+			for {
+				var row []bigquery.Value
+				if err := it.Next(&row); err != nil {
+					if err == iterator.Done {
+						break
+					}
+					t.Fatalf("Failed to read row: %v", err)
+				}
+				scenarios = append(scenarios, row[0].(string))
+			}
+			if len(scenarios) != 1 || scenarios[0] != scenario {
+				t.Fatalf("Table contains %v, want only %s", scenarios, scenario)
+			}
+			if ids := tableIDs(t); len(ids) != 2 {
+				t.Fatalf("Dataset has tables %v, want users and users_%s", ids, scenario)
+			}
+		})
+	}
+
+	// Reset keeps the project and dataset in place
+	h.Reset(t)
+	if _, err := h.Client.Dataset(h.DatasetID).Metadata(ctx); err != nil {
+		t.Fatalf("Dataset %s missing after Reset: %v", h.DatasetID, err)
+	}
+	if ids := tableIDs(t); len(ids) != 0 {
+		t.Fatalf("Dataset has tables %v after Reset, want none", ids)
+	}
+}
@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"testing"
+)
+
+func TestHashFunctions(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing FARM_FINGERPRINT, MD5 and SHA256 with BigQuery Emulator ===")
+
+	// Create and seed the table, including a duplicate name and a NULL
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, name) VALUES (1, 'Alice'), (2, 'Bob'), (3, 'Alice'), (4, NULL)")
+	t.Log("✓ Table created and seeded")
+
+	t.Log("2. Hashing each name...")
+	rows := h.Query(t, "SELECT id, name, FARM_FINGERPRINT(name), MD5(name), SHA256(name) FROM "+tableName+" ORDER BY id")
+	if len(rows) != 4 {
+		t.Fatalf("Read %d rows, want 4", len(rows))
+	}
+	for _, row := range rows {
+		t.Logf("  ID: %v, Name: %v, Fingerprint: %v", row[0], row[1], row[2])
+	}
+
+	// FARM_FINGERPRINT is deterministic and distinguishes different inputs
+	alice, bob, aliceAgain := rows[0][2].(int64), rows[1][2].(int64), rows[2][2].(int64)
+	if alice != aliceAgain {
+		t.Fatalf("FARM_FINGERPRINT('Alice') returned %d and %d, want the same value", alice, aliceAgain)
+	}
+	if alice == bob {
+		t.Fatalf("FARM_FINGERPRINT returned %d for both Alice and Bob, want different values", alice)
+	}
+	t.Log("✓ FARM_FINGERPRINT is deterministic and distinguishes inputs")
+
+	// MD5 and SHA256 return BYTES matching the standard digests
+	for i, name := range []string{"Alice", "Bob"} {
+		wantMD5 := md5.Sum([]byte(name))
+		wantSHA256 := sha256.Sum256([]byte(name))
+		if got, ok := rows[i][3].([]byte); !ok || !bytes.Equal(got, wantMD5[:]) {
+			t.Fatalf("MD5(%q) returned %x, want %x", name, rows[i][3], wantMD5)
+		}
+		if got, ok := rows[i][4].([]byte); !ok || !bytes.Equal(got, wantSHA256[:]) {
+			t.Fatalf("SHA256(%q) returned %x, want %x", name, rows[i][4], wantSHA256)
+		}
+	}
+	t.Log("✓ MD5 and SHA256 match the standard digests")
+
+	// Hashing NULL yields NULL
+	t.Log("3. Verifying NULL input...")
+	for col, name := range []string{"FARM_FINGERPRINT", "MD5", "SHA256"} {
+		if got := rows[3][col+2]; got != nil {
+			t.Fatalf("%s(NULL) returned %v, want NULL", name, got)
+		}
+	}
+	t.Log("✓ Hashing NULL returns NULL")
+
+	t.Log("=== Hash function test completed successfully! ===")
+}
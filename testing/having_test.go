@@ -0,0 +1,49 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestHavingUnprojectedAggregates(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing HAVING on aggregates not in SELECT with BigQuery Emulator ===")
+
+	// Create and seed the table
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, status STRING, age INT64)")
+	h.Exec(t, `INSERT INTO `+tableName+` (id, status, age) VALUES
+    (1, 'active', 25), (2, 'active', 35), (3, 'active', 28),
+    (4, 'inactive', 20), (5, 'inactive', 30),
+    (6, 'pending', 45)`)
+	t.Log("✓ Table created and seeded")
+
+	for i, tc := range []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{
+			name: "HAVING COUNT(*) > 1",
+			sql:  "SELECT status FROM " + tableName + " GROUP BY status HAVING COUNT(*) > 1 ORDER BY status",
+			want: "[[active] [inactive]]",
+		},
+		{
+			// inactive's oldest is exactly 30, so it is excluded
+			name: "HAVING MAX(age) > 30",
+			sql:  "SELECT status FROM " + tableName + " GROUP BY status HAVING MAX(age) > 30 ORDER BY status",
+			want: "[[active] [pending]]",
+		},
+	} {
+		t.Logf("%d. Querying with %s...", i+2, tc.name)
+		rows := h.Query(t, tc.sql)
+		if got := fmt.Sprint(rows); got != tc.want {
+			t.Fatalf("%s returned %s, want %s", tc.name, got, tc.want)
+		}
+		t.Logf("✓ %s returned %s", tc.name, tc.want)
+	}
+
+	t.Log("=== HAVING test completed successfully! ===")
+}
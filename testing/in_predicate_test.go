@@ -0,0 +1,52 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestInPredicate(t *testing.T) {
+	h := NewHarness(t)
+	usersTable := h.TableName("users")
+	ordersTable := h.TableName("orders")
+
+	t.Log("=== Testing IN predicates with BigQuery Emulator ===")
+
+	// Create and seed users and orders
+	t.Log("1. Creating and seeding users and orders...")
+	h.Exec(t, "CREATE TABLE "+usersTable+" (id INT64, name STRING)")
+	h.Exec(t, "INSERT INTO "+usersTable+" (id, name) VALUES (1, 'Alice'), (2, 'Bob'), (3, 'Charlie'), (4, 'David')")
+	h.Exec(t, "CREATE TABLE "+ordersTable+" (id INT64, user_id INT64)")
+	h.Exec(t, "INSERT INTO "+ordersTable+" (id, user_id) VALUES (10, 2), (11, 4), (12, 2)")
+	t.Log("✓ Tables created and seeded")
+
+	// queryIDs returns the user ids matching a WHERE clause
+	queryIDs := func(where string) string {
+		t.Helper()
+		var ids []any
+		for _, row := range h.Query(t, "SELECT id FROM "+usersTable+" WHERE "+where+" ORDER BY id") {
+			ids = append(ids, row[0])
+		}
+		return fmt.Sprint(ids)
+	}
+
+	for i, tc := range []struct {
+		description string
+		where       string
+		want        string
+	}{
+		{description: "IN with a literal list", where: "id IN (1, 3)", want: "[1 3]"},
+		{description: "IN with a subquery", where: "id IN (SELECT user_id FROM " + ordersTable + ")", want: "[2 4]"},
+		{description: "NOT IN with a literal list", where: "id NOT IN (1, 3)", want: "[2 4]"},
+		// x NOT IN (..., NULL) is never TRUE, so no rows match
+		{description: "NOT IN with a list containing NULL", where: "id NOT IN (1, NULL)", want: "[]"},
+	} {
+		t.Logf("%d. Filtering with %s...", i+2, tc.description)
+		if got := queryIDs(tc.where); got != tc.want {
+			t.Fatalf("%s matched ids %s, want %s", tc.description, got, tc.want)
+		}
+		t.Logf("✓ %s matched ids %s", tc.description, tc.want)
+	}
+
+	t.Log("=== IN predicate test completed successfully! ===")
+}
@@ -0,0 +1,52 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestInformationSchemaOptions(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+	schemaPrefix := "`" + h.ProjectID + "." + h.DatasetID + ".INFORMATION_SCHEMA."
+
+	t.Log("=== Testing INFORMATION_SCHEMA option views with BigQuery Emulator ===")
+
+	// Create the table
+	t.Log("1. Creating table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING)")
+	t.Log("✓ Table created successfully")
+
+	// Set table and column descriptions
+	t.Log("2. Setting table and column descriptions...")
+	h.Exec(t, "ALTER TABLE "+tableName+" SET OPTIONS (description = 'All users')")
+	h.Exec(t, "ALTER TABLE "+tableName+" ALTER COLUMN name SET OPTIONS (description = 'Display name')")
+	t.Log("✓ Descriptions set")
+
+	// option_value holds the option as a GoogleSQL literal, so strings are quoted
+	for i, tc := range []struct {
+		view string
+		sql  string
+		want string
+	}{
+		{
+			view: "TABLE_OPTIONS",
+			sql:  "SELECT option_name, option_value FROM " + schemaPrefix + "TABLE_OPTIONS` WHERE table_name = 'users' AND option_name = 'description'",
+			want: `[[description "All users"]]`,
+		},
+		{
+			view: "COLUMN_OPTIONS",
+			sql:  "SELECT column_name, option_name, option_value FROM " + schemaPrefix + "COLUMN_OPTIONS` WHERE table_name = 'users' ORDER BY column_name, option_name",
+			want: `[[name description "Display name"]]`,
+		},
+	} {
+		t.Logf("%d. Querying INFORMATION_SCHEMA.%s...", i+3, tc.view)
+		rows := h.Query(t, tc.sql)
+		if got := fmt.Sprint(rows); got != tc.want {
+			t.Fatalf("%s returned %s, want %s", tc.view, got, tc.want)
+		}
+		t.Logf("✓ %s returned %s", tc.view, tc.want)
+	}
+
+	t.Log("=== INFORMATION_SCHEMA option views test completed successfully! ===")
+}
@@ -0,0 +1,47 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestInsertMultipleDefaults(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing INSERT with multiple default columns with BigQuery Emulator ===")
+
+	// Create a table with three defaulted columns
+	t.Log("1. Creating table with three defaulted columns...")
+	h.Exec(t, `
+CREATE TABLE `+tableName+` (
+    id INT64,
+    status STRING DEFAULT 'active',
+    score INT64 DEFAULT 100,
+    verified BOOL DEFAULT FALSE
+)`)
+	t.Log("✓ Table created successfully")
+
+	// Provide one defaulted column explicitly and omit the other two
+	t.Log("2. Inserting with score explicit and status, verified omitted...")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, score) VALUES (1, 5)")
+	t.Log("✓ Row inserted")
+
+	// Omit all three defaulted columns
+	t.Log("3. Inserting with every defaulted column omitted...")
+	h.Exec(t, "INSERT INTO "+tableName+" (id) VALUES (2)")
+	t.Log("✓ Row inserted")
+
+	// Only omitted columns take their defaults
+	t.Log("4. Verifying each column...")
+	rows := h.Query(t, "SELECT id, status, score, verified FROM "+tableName+" ORDER BY id")
+	for _, row := range rows {
+		t.Logf("  ID: %v, Status: %v, Score: %v, Verified: %v", row[0], row[1], row[2], row[3])
+	}
+	if got, want := fmt.Sprint(rows), "[[1 active 5 false] [2 active 100 false]]"; got != want {
+		t.Fatalf("Rows are %s, want %s", got, want)
+	}
+	t.Log("✓ Defaults applied per omitted column and the explicit value kept")
+
+	t.Log("=== INSERT with multiple default columns test completed successfully! ===")
+}
@@ -0,0 +1,66 @@
+package testing
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestInsertValidationErrors(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing INSERT validation errors with BigQuery Emulator ===")
+
+	// Create the target table
+	t.Log("1. Creating table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING)")
+	t.Log("✓ Table created successfully")
+
+	for i, tc := range []struct {
+		name string
+		sql  string
+		// wantParts must all appear, case-insensitively, in the error message
+		wantParts []string
+	}{
+		{
+			name:      "too few values",
+			sql:       "INSERT INTO " + tableName + " (id, name) VALUES (1)",
+			wantParts: []string{"column count", "has 1", "expected 2"},
+		},
+		{
+			name:      "too many values",
+			sql:       "INSERT INTO " + tableName + " (id, name) VALUES (1, 'Alice', 'extra')",
+			wantParts: []string{"column count", "has 3", "expected 2"},
+		},
+		{
+			name:      "type mismatch",
+			sql:       "INSERT INTO " + tableName + " (id) VALUES ('abc')",
+			wantParts: []string{"STRING", "cannot be inserted into column id", "INT64"},
+		},
+	} {
+		t.Logf("%d. Executing INSERT with %s...", i+2, tc.name)
+		err := Exec(ctx, h.Client, tc.sql)
+		if err == nil {
+			t.Fatalf("INSERT with %s succeeded, want an error", tc.name)
+		}
+		t.Logf("  Error: %v", err)
+		msg := strings.ToLower(err.Error())
+		for _, part := range tc.wantParts {
+			if !strings.Contains(msg, strings.ToLower(part)) {
+				t.Fatalf("Error for %s is %q, want it to mention %q", tc.name, err, part)
+			}
+		}
+		t.Logf("✓ INSERT with %s rejected", tc.name)
+	}
+
+	// None of the rejected inserts may have written a row
+	t.Log("5. Verifying table is still empty...")
+	if rows := h.Query(t, "SELECT COUNT(*) FROM "+tableName); rows[0][0].(int64) != 0 {
+		t.Fatalf("Table has %v rows after rejected inserts, want 0", rows[0][0])
+	}
+	t.Log("✓ Table is still empty")
+
+	t.Log("=== INSERT validation error test completed successfully! ===")
+}
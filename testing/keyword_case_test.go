@@ -0,0 +1,166 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestKeywordCase(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing case-insensitive keywords with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// runScenario executes the ADD COLUMN scenario with the given statements
+	// and returns the resulting column names and rows
+	runScenario := func(table string, statements []string) (string, [][]bigquery.Value) {
+		t.Helper()
+		for _, sql := range statements {
+			t.Logf("Executing: %s", sql)
+			if err := Exec(ctx, client, sql); err != nil {
+				t.Fatalf("Failed to execute %q: %v", sql, err)
+			}
+		}
+		meta, err := client.Dataset(datasetID).Table(table).Metadata(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get metadata for %s: %v", table, err)
+		}
+		var columns []string
+		for _, field := range meta.Schema {
+			columns = append(columns, field.Name+" "+string(field.Type))
+		}
+		it, err := client.Query(`SELECT id, name, age FROM ` + "`" + projectID + "." + datasetID + "." + table + "`" + ` ORDER BY id`).Read(ctx)
+		if err != nil {
+			t.Fatalf("Failed to query %s: %v", table, err)
+		}
+		var rows [][]bigquery.Value
+		for {
+			var row []bigquery.Value
+			if err := it.Next(&row); err != nil {
+				if err == iterator.Done {
+					break
+				}
+				t.Fatalf("Failed to read row: %v", err)
+			}
+			rows = append(rows, row)
+		}
+		return strings.Join(columns, ","), rows
+	}
+
+	upperTable := tableName + "_upper"
+	lowerTable := tableName + "_lower"
+	mixedTable := tableName + "_mixed"
+
+	// Run the scenario with uppercase keywords
+	t.Log("4. Running ADD COLUMN scenario with uppercase keywords...")
+	upperColumns, upperRows := runScenario(tableID+"_upper", []string{
+		"CREATE TABLE `" + upperTable + "` (id INT64, name STRING)",
+		"INSERT INTO `" + upperTable + "` (id, name) VALUES (1, 'Alice'), (2, 'Bob')",
+		"ALTER TABLE `" + upperTable + "` ADD COLUMN age INT64",
+		"INSERT INTO `" + upperTable + "` (id, name, age) VALUES (3, 'Charlie', 25)",
+	})
+	if want := "id INTEGER,name STRING,age INTEGER"; upperColumns != want {
+		t.Fatalf("Uppercase scenario columns are %s, want %s", upperColumns, want)
+	}
+	if got, want := fmt.Sprint(upperRows), "[[1 Alice <nil>] [2 Bob <nil>] [3 Charlie 25]]"; got != want {
+		t.Fatalf("Uppercase scenario rows are %s, want %s", got, want)
+	}
+	t.Logf("✓ Uppercase scenario columns: %s", upperColumns)
+
+	// Run the same scenario with lowercase keywords
+	t.Log("5. Running ADD COLUMN scenario with lowercase keywords...")
+	lowerColumns, lowerRows := runScenario(tableID+"_lower", []string{
+		"create table `" + lowerTable + "` (id int64, name string)",
+		"insert into `" + lowerTable + "` (id, name) values (1, 'Alice'), (2, 'Bob')",
+		"alter table `" + lowerTable + "` add column age int64",
+		"insert into `" + lowerTable + "` (id, name, age) values (3, 'Charlie', 25)",
+	})
+	t.Logf("✓ Lowercase scenario columns: %s", lowerColumns)
+
+	// Run the same scenario with mixed case inside each statement
+	t.Log("6. Running ADD COLUMN scenario with mixed-case keywords...")
+	mixedColumns, mixedRows := runScenario(tableID+"_mixed", []string{
+		"Create TABLE `" + mixedTable + "` (id Int64, name String)",
+		"INSERT into `" + mixedTable + "` (id, name) Values (1, 'Alice'), (2, 'Bob')",
+		"Alter Table `" + mixedTable + "` ADD column age INT64",
+		"insert INTO `" + mixedTable + "` (id, name, age) VALUES (3, 'Charlie', 25)",
+	})
+	t.Logf("✓ Mixed-case scenario columns: %s", mixedColumns)
+
+	// Verify all scenarios produced identical results
+	t.Log("7. Comparing scenario results...")
+	for _, got := range []struct {
+		name    string
+		columns string
+		rows    [][]bigquery.Value
+	}{
+		{name: "lowercase", columns: lowerColumns, rows: lowerRows},
+		{name: "mixed-case", columns: mixedColumns, rows: mixedRows},
+	} {
+		if got.columns != upperColumns {
+			t.Fatalf("%s scenario columns are %s, want %s", got.name, got.columns, upperColumns)
+		}
+		if fmt.Sprint(got.rows) != fmt.Sprint(upperRows) {
+			t.Fatalf("%s scenario rows are %v, want %v", got.name, got.rows, upperRows)
+		}
+	}
+	t.Log("✓ Keyword case does not change behavior")
+
+	t.Log("=== case-insensitive keywords test completed successfully! ===")
+}
@@ -0,0 +1,54 @@
+package testing
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// The emulator only implements GoogleSQL. Queries sent with UseLegacySQL must
+// be rejected with an error that names legacy SQL instead of being parsed as
+// standard SQL.
+func TestLegacySQL(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+
+	t.Log("=== Testing legacy SQL dialect toggle with BigQuery Emulator ===")
+
+	// Create and seed a table with standard SQL
+	t.Log("1. Creating and seeding table with standard SQL...")
+	h.Exec(t, "CREATE TABLE "+h.TableName("users")+" (id INT64, name STRING)")
+	h.Exec(t, "INSERT INTO "+h.TableName("users")+" (id, name) VALUES (1, 'Alice')")
+	t.Log("✓ Table created and seeded")
+
+	// Run a query using legacy table reference syntax
+	t.Log("2. Executing a legacy SQL query...")
+	q := h.Client.Query("SELECT name FROM [" + h.ProjectID + ":" + h.DatasetID + ".users]")
+	q.UseLegacySQL = true
+	_, err := q.Read(ctx)
+	if err == nil {
+		t.Fatalf("Legacy SQL query should be rejected, but succeeded")
+	}
+	if !strings.Contains(strings.ToLower(err.Error()), "legacy sql") {
+		t.Fatalf("Legacy SQL error %q does not mention legacy SQL", err)
+	}
+	t.Logf("✓ Legacy SQL query rejected (error: %v)", err)
+
+	// A standard SQL query with legacy syntax must still fail to parse
+	t.Log("3. Executing legacy syntax as standard SQL...")
+	if _, err := h.Client.Query("SELECT name FROM [" + h.ProjectID + ":" + h.DatasetID + ".users]").Read(ctx); err == nil {
+		t.Fatalf("Legacy syntax should not parse as standard SQL, but query succeeded")
+	} else {
+		t.Logf("✓ Legacy syntax rejected by standard SQL (error: %v)", err)
+	}
+
+	// Standard SQL keeps working
+	t.Log("4. Executing the standard SQL equivalent...")
+	rows := h.Query(t, "SELECT name FROM "+h.TableName("users"))
+	if len(rows) != 1 || rows[0][0] != "Alice" {
+		t.Fatalf("Standard SQL query returned %v, want Alice", rows)
+	}
+	t.Log("✓ Standard SQL query returned expected rows")
+
+	t.Log("=== Legacy SQL test completed successfully! ===")
+}
@@ -0,0 +1,41 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLimitOffset(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing LIMIT and OFFSET with BigQuery Emulator ===")
+
+	// Create and seed the table
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, name) VALUES (1, 'Alice'), (2, 'Bob'), (3, 'Charlie'), (4, 'David')")
+	t.Log("✓ Table created and seeded")
+
+	for i, tc := range []struct {
+		clause string
+		want   string
+	}{
+		{clause: "LIMIT 2 OFFSET 1", want: "[2 3]"},
+		{clause: "LIMIT 0", want: "[]"},
+		{clause: "LIMIT 10 OFFSET 100", want: "[]"},
+		{clause: "LIMIT 10 OFFSET 3", want: "[4]"},
+	} {
+		t.Logf("%d. Querying with ORDER BY id %s...", i+2, tc.clause)
+		var ids []any
+		for _, row := range h.Query(t, "SELECT id FROM "+tableName+" ORDER BY id "+tc.clause) {
+			ids = append(ids, row[0])
+		}
+		if got := fmt.Sprint(ids); got != tc.want {
+			t.Fatalf("%s returned ids %s, want %s", tc.clause, got, tc.want)
+		}
+		t.Logf("✓ %s returned ids %s", tc.clause, tc.want)
+	}
+
+	t.Log("=== LIMIT and OFFSET test completed successfully! ===")
+}
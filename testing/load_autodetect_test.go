@@ -0,0 +1,199 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestLoadAutodetect(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing schema auto-detection on load with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Build a CSV source with a header row and no explicit schema
+	t.Log("4. Preparing CSV source with auto-detection...")
+	csvData := "id,name,score\n1,Alice,10\n2,Bob,7.5\n"
+	source := bigquery.NewReaderSource(strings.NewReader(csvData))
+	source.SourceFormat = bigquery.CSV
+	source.AutoDetect = true
+	source.SkipLeadingRows = 1
+	t.Log("✓ CSV source prepared")
+
+	// Load the CSV into a new table
+	t.Log("5. Loading CSV into a new table...")
+	job, err := client.Dataset(datasetID).Table(tableID).LoaderFrom(source).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to start load job: %v", err)
+	}
+	status, err := job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for load job: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Load job failed: %v", err)
+	}
+	t.Log("✓ CSV loaded successfully")
+
+	// Verify the inferred schema
+	t.Log("6. Verifying inferred schema...")
+	meta, err := client.Dataset(datasetID).Table(tableID).Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get table metadata: %v", err)
+	}
+	want := []struct {
+		name      string
+		fieldType bigquery.FieldType
+	}{
+		{name: "id", fieldType: bigquery.IntegerFieldType},
+		{name: "name", fieldType: bigquery.StringFieldType},
+		// Mixed integer and decimal values widen to FLOAT64
+		{name: "score", fieldType: bigquery.FloatFieldType},
+	}
+	if len(meta.Schema) != len(want) {
+		t.Fatalf("Inferred schema has %d columns, want %d", len(meta.Schema), len(want))
+	}
+	for i, field := range meta.Schema {
+		t.Logf("  Column: %s, Type: %s", field.Name, field.Type)
+		if field.Name != want[i].name || field.Type != want[i].fieldType {
+			t.Fatalf("Column %d is %s %s, want %s %s", i, field.Name, field.Type, want[i].name, want[i].fieldType)
+		}
+	}
+	t.Log("✓ Schema inferred as INT64, STRING and FLOAT64")
+
+	// Verify the loaded data
+	t.Log("7. Verifying loaded data...")
+	querySQL := `SELECT id, name, score FROM ` + "`" + tableName + "`" + ` ORDER BY id`
+	it, err := client.Query(querySQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query loaded data: %v", err)
+	}
+	var rows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	for _, row := range rows {
+		t.Logf("  ID: %v, Name: %v, Score: %v", row[0], row[1], row[2])
+	}
+	if len(rows) != 2 || rows[0][2] != float64(10) || rows[1][2] != 7.5 {
+		t.Fatalf("Loaded rows are %v, want scores 10 and 7.5", rows)
+	}
+	t.Log("✓ Loaded data matches CSV contents")
+
+	// Load newline-delimited JSON into a second table with auto-detection
+	t.Log("8. Loading newline-delimited JSON with auto-detection...")
+	jsonTableID := tableID + "_json"
+	jsonData := `{"id": 1, "name": "Alice", "active": true, "score": 10}
+{"id": 2, "name": "Bob", "active": false, "score": 7.5}
+`
+	jsonSource := bigquery.NewReaderSource(strings.NewReader(jsonData))
+	jsonSource.SourceFormat = bigquery.JSON
+	jsonSource.AutoDetect = true
+	job, err = client.Dataset(datasetID).Table(jsonTableID).LoaderFrom(jsonSource).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to start JSON load job: %v", err)
+	}
+	status, err = job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for JSON load job: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("JSON load job failed: %v", err)
+	}
+	t.Log("✓ JSON loaded successfully")
+
+	// JSON objects have no column order, so columns are matched by name
+	t.Log("9. Verifying schema and data inferred from JSON...")
+	meta, err = client.Dataset(datasetID).Table(jsonTableID).Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get JSON table metadata: %v", err)
+	}
+	wantJSONTypes := map[string]bigquery.FieldType{
+		"id":     bigquery.IntegerFieldType,
+		"name":   bigquery.StringFieldType,
+		"active": bigquery.BooleanFieldType,
+		"score":  bigquery.FloatFieldType,
+	}
+	if len(meta.Schema) != len(wantJSONTypes) {
+		t.Fatalf("Inferred JSON schema has %d columns, want %d", len(meta.Schema), len(wantJSONTypes))
+	}
+	for _, field := range meta.Schema {
+		t.Logf("  Column: %s, Type: %s", field.Name, field.Type)
+		if want, ok := wantJSONTypes[field.Name]; !ok || field.Type != want {
+			t.Fatalf("Column %s has type %s, want %s", field.Name, field.Type, want)
+		}
+	}
+	jsonRows, err := QueryRowsByName(ctx, client, "SELECT id, name, active, score FROM `"+projectID+"."+datasetID+"."+jsonTableID+"` ORDER BY id")
+	if err != nil {
+		t.Fatalf("Failed to query JSON data: %v", err)
+	}
+	if got, want := fmt.Sprint(jsonRows), "[map[active:true id:1 name:Alice score:10] map[active:false id:2 name:Bob score:7.5]]"; got != want {
+		t.Fatalf("Loaded JSON rows are %s, want %s", got, want)
+	}
+	t.Log("✓ Schema inferred as INT64, STRING, BOOL and FLOAT64 from JSON")
+
+	t.Log("=== schema auto-detection on load test completed successfully! ===")
+}
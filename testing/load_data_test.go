@@ -0,0 +1,247 @@
+package testing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestLoadData(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing LOAD DATA with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating initial table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING
+)`
+	job, err := client.Query(createTableSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	status, err := job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for create table: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Create table failed: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Insert test data
+	t.Log("5. Inserting test data...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name)
+VALUES (1, 'Alice')`
+	job, err = client.Query(insertSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	status, err = job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for insert data: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Insert data failed: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// Write the CSV file to load
+	t.Log("6. Writing CSV file to load...")
+	csvPath := filepath.Join(t.TempDir(), "users.csv")
+	if err := os.WriteFile(csvPath, []byte("id,name\n2,Bob\n3,Charlie\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write CSV file: %v", err)
+	}
+	t.Log("✓ CSV file written successfully")
+
+	// queryNames returns the names in the table ordered by id
+	querySQL := `SELECT id, name FROM ` + "`" + tableName + "`" + ` ORDER BY id`
+	queryNames := func() []string {
+		t.Helper()
+		it, err := client.Query(querySQL).Read(ctx)
+		if err != nil {
+			t.Fatalf("Failed to query table: %v", err)
+		}
+		var names []string
+		for {
+			var row []bigquery.Value
+			if err := it.Next(&row); err != nil {
+				if err == iterator.Done {
+					break
+				}
+				t.Fatalf("Failed to read row: %v", err)
+			}
+			t.Logf("  ID: %v, Name: %v", row[0], row[1])
+			names = append(names, row[1].(string))
+		}
+		return names
+	}
+
+	// Execute LOAD DATA INTO, which appends to the existing rows
+	t.Log("7. Executing LOAD DATA INTO via BigQuery client...")
+	loadSQL := `
+LOAD DATA INTO ` + "`" + tableName + "`" + `
+FROM FILES (
+    format = 'CSV',
+    uris = ['file://` + csvPath + `'],
+    skip_leading_rows = 1
+)`
+	t.Logf("Executing: %s", loadSQL)
+	job, err = client.Query(loadSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to load data: %v", err)
+	}
+	status, err = job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for load data: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Load data failed: %v", err)
+	}
+	t.Log("✓ Data loaded successfully")
+
+	// Verify the loaded rows were appended
+	t.Log("8. Verifying loaded rows were appended...")
+	if got, want := strings.Join(queryNames(), ","), "Alice,Bob,Charlie"; got != want {
+		t.Fatalf("Table contains %s, want %s", got, want)
+	}
+	t.Log("✓ Loaded rows appended to existing rows")
+
+	// Execute LOAD DATA OVERWRITE, which replaces the existing rows
+	t.Log("9. Executing LOAD DATA OVERWRITE via BigQuery client...")
+	overwriteSQL := `
+LOAD DATA OVERWRITE ` + "`" + tableName + "`" + `
+FROM FILES (
+    format = 'CSV',
+    uris = ['file://` + csvPath + `'],
+    skip_leading_rows = 1
+)`
+	t.Logf("Executing: %s", overwriteSQL)
+	job, err = client.Query(overwriteSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to overwrite data: %v", err)
+	}
+	status, err = job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for overwrite data: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Overwrite data failed: %v", err)
+	}
+	t.Log("✓ Data overwritten successfully")
+
+	// Verify only the loaded rows remain
+	t.Log("10. Verifying existing rows were replaced...")
+	if got, want := strings.Join(queryNames(), ","), "Bob,Charlie"; got != want {
+		t.Fatalf("Table contains %s, want %s", got, want)
+	}
+	t.Log("✓ Existing rows replaced by loaded rows")
+
+	// Execute LOAD DATA from a JSON file into a new table
+	t.Log("11. Executing LOAD DATA from JSON into a new table...")
+	jsonPath := filepath.Join(t.TempDir(), "users.json")
+	if err := os.WriteFile(jsonPath, []byte(`{"id": 4, "name": "David"}`+"\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write JSON file: %v", err)
+	}
+	newTableName := projectID + "." + datasetID + ".users_json"
+	loadJSONSQL := `
+LOAD DATA INTO ` + "`" + newTableName + "`" + ` (id INT64, name STRING)
+FROM FILES (
+    format = 'JSON',
+    uris = ['file://` + jsonPath + `']
+)`
+	t.Logf("Executing: %s", loadJSONSQL)
+	job, err = client.Query(loadJSONSQL).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to load JSON data: %v", err)
+	}
+	status, err = job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait for load JSON data: %v", err)
+	}
+	if err := status.Err(); err != nil {
+		t.Fatalf("Load JSON data failed: %v", err)
+	}
+	countSQL := `SELECT COUNT(*) FROM ` + "`" + newTableName + "`"
+	it, err := client.Query(countSQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query new table: %v", err)
+	}
+	var rows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) != 1 || rows[0][0] != int64(1) {
+		t.Fatalf("New table row count is %v, want 1", rows)
+	}
+	t.Log("✓ JSON file loaded into new table")
+
+	t.Log("=== LOAD DATA test completed successfully! ===")
+}
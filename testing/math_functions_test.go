@@ -0,0 +1,48 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestMathFunctions(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+
+	t.Log("=== Testing ROUND, TRUNC, CEIL, FLOOR and MOD with BigQuery Emulator ===")
+
+	// FLOAT64 inputs give FLOAT64 results; MOD of INT64 stays INT64
+	t.Log("1. Evaluating math functions...")
+	for _, tc := range []struct {
+		expr string
+		want string
+	}{
+		{expr: "ROUND(3.14159, 2)", want: "float64 3.14"},
+		{expr: "ROUND(1234.5, -2)", want: "float64 1200"},
+		{expr: "ROUND(2.5)", want: "float64 3"},
+		{expr: "TRUNC(3.99)", want: "float64 3"},
+		{expr: "TRUNC(-3.99)", want: "float64 -3"},
+		{expr: "CEIL(3.1)", want: "float64 4"},
+		{expr: "FLOOR(3.9)", want: "float64 3"},
+		{expr: "MOD(10, 3)", want: "int64 1"},
+		{expr: "MOD(-10, 3)", want: "int64 -1"},
+	} {
+		rows := h.Query(t, "SELECT "+tc.expr)
+		if got := fmt.Sprintf("%T %v", rows[0][0], rows[0][0]); got != tc.want {
+			t.Fatalf("%s returned %s, want %s", tc.expr, got, tc.want)
+		}
+		t.Logf("  %s -> %s", tc.expr, tc.want)
+	}
+	t.Log("✓ Math functions returned exact results")
+
+	// MOD by zero is an error, unlike SAFE.MOD
+	t.Log("2. Evaluating MOD with a zero divisor...")
+	if err := Exec(ctx, h.Client, "SELECT MOD(10, 0)"); err == nil {
+		t.Fatal("MOD(10, 0) succeeded, want a division by zero error")
+	} else {
+		t.Logf("✓ MOD(10, 0) returned error: %v", err)
+	}
+
+	t.Log("=== Math function test completed successfully! ===")
+}
@@ -0,0 +1,92 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/bigquery"
+)
+
+// TableSnapshot is the part of a table's metadata that ALTER statements can
+// change, normalized so that two snapshots compare with reflect.DeepEqual or
+// their JSON encoding. Empty labels and clustering are nil.
+//
+// PartitionType is the time partitioning granularity, such as DAY, or RANGE
+// for integer range partitioning, whose bounds are in the Range fields.
+type TableSnapshot struct {
+	Description    string            `json:"description,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	PartitionType  string            `json:"partition_type,omitempty"`
+	PartitionField string            `json:"partition_field,omitempty"`
+	RangeStart     int64             `json:"range_start,omitempty"`
+	RangeEnd       int64             `json:"range_end,omitempty"`
+	RangeInterval  int64             `json:"range_interval,omitempty"`
+	Clustering     []string          `json:"clustering,omitempty"`
+	Columns        []ColumnSnapshot  `json:"columns"`
+}
+
+// rangePartitionType is the PartitionType of an integer range partitioned
+// table.
+const rangePartitionType = "RANGE"
+
+// ColumnSnapshot is one column of a TableSnapshot. Fields holds the members
+// of a STRUCT column.
+type ColumnSnapshot struct {
+	Name         string           `json:"name"`
+	Type         string           `json:"type"`
+	Mode         FieldMode        `json:"mode"`
+	Description  string           `json:"description,omitempty"`
+	DefaultValue string           `json:"default_value,omitempty"`
+	Fields       []ColumnSnapshot `json:"fields,omitempty"`
+}
+
+// CaptureMetadata reads the metadata of dataset.table and returns its snapshot.
+func CaptureMetadata(ctx context.Context, client *bigquery.Client, dataset, table string) (TableSnapshot, error) {
+	meta, err := client.Dataset(dataset).Table(table).Metadata(ctx)
+	if err != nil {
+		return TableSnapshot{}, fmt.Errorf("failed to get metadata for %s.%s: %w", dataset, table, err)
+	}
+
+	snapshot := TableSnapshot{
+		Description: meta.Description,
+		Columns:     columnSnapshots(meta.Schema),
+	}
+	if len(meta.Labels) > 0 {
+		snapshot.Labels = meta.Labels
+	}
+	if tp := meta.TimePartitioning; tp != nil {
+		snapshot.PartitionType = string(tp.Type)
+		snapshot.PartitionField = tp.Field
+	}
+	if rp := meta.RangePartitioning; rp != nil {
+		snapshot.PartitionType = rangePartitionType
+		snapshot.PartitionField = rp.Field
+		if r := rp.Range; r != nil {
+			snapshot.RangeStart = r.Start
+			snapshot.RangeEnd = r.End
+			snapshot.RangeInterval = r.Interval
+		}
+	}
+	if c := meta.Clustering; c != nil && len(c.Fields) > 0 {
+		snapshot.Clustering = c.Fields
+	}
+	return snapshot, nil
+}
+
+func columnSnapshots(schema bigquery.Schema) []ColumnSnapshot {
+	if len(schema) == 0 {
+		return nil
+	}
+	columns := make([]ColumnSnapshot, len(schema))
+	for i, field := range schema {
+		columns[i] = ColumnSnapshot{
+			Name:         field.Name,
+			Type:         string(field.Type),
+			Mode:         fieldMode(field),
+			Description:  field.Description,
+			DefaultValue: field.DefaultValueExpression,
+			Fields:       columnSnapshots(field.Schema),
+		}
+	}
+	return columns
+}
@@ -0,0 +1,36 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/bigquery"
+)
+
+// MigrationError reports the statement at which a migration stopped.
+type MigrationError struct {
+	// Index is the zero-based position of the failing statement.
+	Index     int
+	Statement string
+	Err       error
+}
+
+func (e *MigrationError) Error() string {
+	return fmt.Sprintf("migration statement %d failed: %v", e.Index, e.Err)
+}
+
+func (e *MigrationError) Unwrap() error {
+	return e.Err
+}
+
+// RunMigration executes statements in order and stops at the first failure,
+// returning a *MigrationError for it. Statements that already ran are not
+// rolled back, matching BigQuery where each DDL statement commits on its own.
+func RunMigration(ctx context.Context, client *bigquery.Client, statements []string) error {
+	for i, statement := range statements {
+		if err := Exec(ctx, client, statement); err != nil {
+			return &MigrationError{Index: i, Statement: statement, Err: err}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestRunMigration(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing RunMigration with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Apply a realistic migration
+	t.Log("4. Applying migration...")
+	migration := []string{
+		"CREATE TABLE `" + tableName + "` (id INT64, name STRING)",
+		"INSERT INTO `" + tableName + "` (id, name) VALUES (1, 'Alice'), (2, 'Bob')",
+		"ALTER TABLE `" + tableName + "` ADD COLUMN age INT64",
+		"UPDATE `" + tableName + "` SET age = id * 10 WHERE true",
+		"ALTER TABLE `" + tableName + "` RENAME COLUMN name TO full_name",
+	}
+	if err := RunMigration(ctx, client, migration); err != nil {
+		t.Fatalf("Failed to apply migration: %v", err)
+	}
+	t.Log("✓ Migration applied successfully")
+
+	// Verify the final schema
+	t.Log("5. Verifying final schema...")
+	meta, err := client.Dataset(datasetID).Table(tableID).Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get table metadata: %v", err)
+	}
+	var columns []string
+	for _, field := range meta.Schema {
+		columns = append(columns, field.Name)
+	}
+	if got, want := strings.Join(columns, ","), "id,full_name,age"; got != want {
+		t.Fatalf("Final columns are %s, want %s", got, want)
+	}
+	t.Log("✓ Final schema matches migration")
+
+	// Verify the final data
+	t.Log("6. Verifying final data...")
+	querySQL := `SELECT id, full_name, age FROM ` + "`" + tableName + "`" + ` ORDER BY id`
+	it, err := client.Query(querySQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query table: %v", err)
+	}
+	var rows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	want := [][]bigquery.Value{
+		{int64(1), "Alice", int64(10)},
+		{int64(2), "Bob", int64(20)},
+	}
+	if fmt.Sprint(rows) != fmt.Sprint(want) {
+		t.Fatalf("Final rows are %v, want %v", rows, want)
+	}
+	t.Log("✓ Final data matches migration")
+
+	// Apply a migration whose third statement fails
+	t.Log("7. Applying a migration that fails at statement 3...")
+	failingTableName := tableName + "_failing"
+	failing := []string{
+		"CREATE TABLE `" + failingTableName + "` (id INT64)",
+		"INSERT INTO `" + failingTableName + "` (id) VALUES (1)",
+		"ALTER TABLE `" + failingTableName + "` DROP COLUMN missing",
+		"INSERT INTO `" + failingTableName + "` (id) VALUES (2)",
+	}
+	err = RunMigration(ctx, client, failing)
+	var migrationErr *MigrationError
+	if !errors.As(err, &migrationErr) {
+		t.Fatalf("Failing migration returned %v, want *MigrationError", err)
+	}
+	if migrationErr.Index != 2 {
+		t.Fatalf("Migration failed at statement %d, want 2", migrationErr.Index)
+	}
+	t.Logf("✓ Migration stopped at statement %d (error: %v)", migrationErr.Index, err)
+
+	// Statements before the failure stay applied
+	t.Log("8. Verifying earlier statements were not rolled back...")
+	countSQL := `SELECT COUNT(*) FROM ` + "`" + failingTableName + "`"
+	it, err = client.Query(countSQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to count rows: %v", err)
+	}
+	var counts [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		counts = append(counts, row)
+	}
+	if len(counts) != 1 || counts[0][0] != int64(1) {
+		t.Fatalf("Failing migration table has count %v, want 1", counts)
+	}
+	t.Log("✓ Statements 1-2 applied and statement 4 never ran")
+
+	t.Log("=== RunMigration test completed successfully! ===")
+}
@@ -0,0 +1,147 @@
+package testing
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestMultipleClients(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing multiple clients on one server with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create a second client against the same test server
+	t.Log("4. Creating a second BigQuery client...")
+	clientB, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create second BigQuery client: %v", err)
+	}
+	defer clientB.Close()
+	t.Log("✓ Second client created successfully")
+
+	// Client A creates and seeds the table
+	t.Log("5. Creating table with client A...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name)
+VALUES (1, 'Alice')`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	t.Log("✓ Table created and seeded by client A")
+
+	// queryNames reads the table's names through the given client
+	querySQL := `SELECT name FROM ` + "`" + tableName + "`" + ` ORDER BY id`
+	queryNames := func(c *bigquery.Client) string {
+		t.Helper()
+		it, err := c.Query(querySQL).Read(ctx)
+		if err != nil {
+			t.Fatalf("Failed to query table: %v", err)
+		}
+		var names []string
+		for {
+			var row []bigquery.Value
+			if err := it.Next(&row); err != nil {
+				if err == iterator.Done {
+					break
+				}
+				t.Fatalf("Failed to read row: %v", err)
+			}
+			names = append(names, row[0].(string))
+		}
+		return strings.Join(names, ",")
+	}
+
+	// Client B sees the table created by client A
+	t.Log("6. Reading table with client B...")
+	if _, err := clientB.Dataset(datasetID).Table(tableID).Metadata(ctx); err != nil {
+		t.Fatalf("Client B cannot see table created by client A: %v", err)
+	}
+	if got := queryNames(clientB); got != "Alice" {
+		t.Fatalf("Client B reads %s, want Alice", got)
+	}
+	t.Log("✓ Client B sees client A's table and rows")
+
+	// Client A sees rows inserted by client B
+	t.Log("7. Inserting with client B and reading with client A...")
+	insertBSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name)
+VALUES (2, 'Bob')`
+	if err := Exec(ctx, clientB, insertBSQL); err != nil {
+		t.Fatalf("Failed to insert data with client B: %v", err)
+	}
+	if got := queryNames(client); got != "Alice,Bob" {
+		t.Fatalf("Client A reads %s, want Alice,Bob", got)
+	}
+	t.Log("✓ Client A sees client B's rows")
+
+	t.Log("=== multiple clients on one server test completed successfully! ===")
+}
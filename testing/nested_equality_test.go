@@ -0,0 +1,70 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestNestedEquality(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing STRUCT and ARRAY equality with BigQuery Emulator ===")
+
+	// Create and seed a table with nested columns
+	t.Log("1. Creating and seeding table with nested columns...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, addr STRUCT<city STRING, zip INT64>, tags ARRAY<STRING>)")
+	h.Exec(t, `INSERT INTO `+tableName+` (id, addr, tags) VALUES
+    (1, STRUCT('SF', 94107), ['a', 'b']),
+    (2, STRUCT('NY', 10001), ['a']),
+    (3, STRUCT('SF', NULL), ['b', 'a'])`)
+	t.Log("✓ Table created and seeded")
+
+	// queryIDs returns the ids matching a WHERE clause
+	queryIDs := func(where string) string {
+		t.Helper()
+		var ids []any
+		for _, row := range h.Query(t, "SELECT id FROM "+tableName+" WHERE "+where+" ORDER BY id") {
+			ids = append(ids, row[0])
+		}
+		return fmt.Sprint(ids)
+	}
+
+	// A struct with a NULL field compares as NULL, so row 3 is excluded
+	t.Log("2. Filtering by STRUCT equality...")
+	if got := queryIDs("addr = STRUCT('SF' AS city, 94107 AS zip)"); got != "[1]" {
+		t.Fatalf("STRUCT equality matched ids %s, want [1]", got)
+	}
+	t.Log("✓ STRUCT equality matched only the exact struct")
+
+	t.Log("3. Filtering by STRUCT equality against a NULL-containing value...")
+	if got := queryIDs("addr = STRUCT('SF' AS city, CAST(NULL AS INT64) AS zip)"); got != "[]" {
+		t.Fatalf("STRUCT equality with a NULL field matched ids %s, want none", got)
+	}
+	t.Log("✓ NULL-containing struct comparison excluded every row")
+
+	// BigQuery does not define = for ARRAY, so the comparison is rejected
+	t.Log("4. Filtering by ARRAY equality...")
+	if err := Exec(ctx, h.Client, "SELECT id FROM "+tableName+" WHERE tags = ['a', 'b']"); err == nil {
+		t.Fatal("ARRAY equality succeeded, want an error")
+	} else if !strings.Contains(err.Error(), "Equality is not defined for arguments of type ARRAY") {
+		t.Fatalf("ARRAY equality returned %v, want an equality not defined error", err)
+	} else {
+		t.Logf("✓ ARRAY equality rejected: %v", err)
+	}
+
+	// Comparing serialized arrays is element-wise and order-sensitive
+	t.Log("5. Filtering by serialized ARRAY equality...")
+	if got := queryIDs("TO_JSON_STRING(tags) = TO_JSON_STRING(['a', 'b'])"); got != "[1]" {
+		t.Fatalf("TO_JSON_STRING array comparison matched ids %s, want [1]", got)
+	}
+	if got := queryIDs("ARRAY_TO_STRING(tags, ',') = 'b,a'"); got != "[3]" {
+		t.Fatalf("ARRAY_TO_STRING array comparison matched ids %s, want [3]", got)
+	}
+	t.Log("✓ Serialized arrays matched only the same elements in order")
+
+	t.Log("=== STRUCT and ARRAY equality test completed successfully! ===")
+}
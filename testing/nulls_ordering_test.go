@@ -0,0 +1,50 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNullsOrdering(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing NULLS FIRST and NULLS LAST with BigQuery Emulator ===")
+
+	// Create and seed a table with some NULL ages
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, age INT64)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, age) VALUES (1, 30), (2, NULL), (3, 20), (4, NULL), (5, 40)")
+	t.Log("✓ Table created and seeded")
+
+	// queryIDs returns the ids in the order produced by an ORDER BY clause
+	queryIDs := func(orderBy string) string {
+		t.Helper()
+		var ids []any
+		for _, row := range h.Query(t, "SELECT id FROM "+tableName+" ORDER BY "+orderBy) {
+			ids = append(ids, row[0])
+		}
+		return fmt.Sprint(ids)
+	}
+
+	for i, tc := range []struct {
+		orderBy string
+		want    string
+	}{
+		// BigQuery sorts NULLs first for ASC and last for DESC by default
+		{orderBy: "age ASC, id", want: "[2 4 3 1 5]"},
+		{orderBy: "age DESC, id", want: "[5 1 3 2 4]"},
+		{orderBy: "age ASC NULLS FIRST, id", want: "[2 4 3 1 5]"},
+		{orderBy: "age ASC NULLS LAST, id", want: "[3 1 5 2 4]"},
+		{orderBy: "age DESC NULLS FIRST, id", want: "[2 4 5 1 3]"},
+		{orderBy: "age DESC NULLS LAST, id", want: "[5 1 3 2 4]"},
+	} {
+		t.Logf("%d. Ordering by %s...", i+2, tc.orderBy)
+		if got := queryIDs(tc.orderBy); got != tc.want {
+			t.Fatalf("ORDER BY %s returned ids %s, want %s", tc.orderBy, got, tc.want)
+		}
+		t.Logf("✓ ORDER BY %s returned ids %s", tc.orderBy, tc.want)
+	}
+
+	t.Log("=== NULLS FIRST and NULLS LAST test completed successfully! ===")
+}
@@ -0,0 +1,50 @@
+package testing
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+func TestNumericSemantics(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+
+	t.Log("=== Testing INT64 and FLOAT64 edge cases with BigQuery Emulator ===")
+
+	// Arithmetic that real BigQuery rejects must error, including FLOAT64
+	// overflow, which is an error rather than +inf outside IEEE_DIVIDE
+	t.Log("1. Executing expressions that must error...")
+	for _, sql := range []string{
+		"SELECT 9223372036854775807 + 1",
+		"SELECT 1 / 0",
+		"SELECT 1e308 * 10",
+	} {
+		if _, err := h.Client.Query(sql).Read(ctx); err == nil {
+			t.Fatalf("%q should fail, but query succeeded", sql)
+		} else {
+			t.Logf("✓ %q failed (error: %v)", sql, err)
+		}
+	}
+
+	// SAFE_DIVIDE returns NULL instead of erroring
+	t.Log("2. Executing SAFE_DIVIDE(1, 0)...")
+	rows := h.Query(t, "SELECT SAFE_DIVIDE(1, 0)")
+	if len(rows) != 1 || rows[0][0] != nil {
+		t.Fatalf("SAFE_DIVIDE(1, 0) returned %v, want NULL", rows)
+	}
+	t.Log("✓ SAFE_DIVIDE(1, 0) returned NULL")
+
+	// IEEE_DIVIDE follows IEEE 754 and returns +inf
+	t.Log("3. Executing IEEE_DIVIDE(1, 0)...")
+	rows = h.Query(t, "SELECT IEEE_DIVIDE(1, 0)")
+	if len(rows) != 1 {
+		t.Fatalf("IEEE_DIVIDE(1, 0) returned %d rows, want 1", len(rows))
+	}
+	if v, ok := rows[0][0].(float64); !ok || !math.IsInf(v, 1) {
+		t.Fatalf("IEEE_DIVIDE(1, 0) returned %v, want +inf", rows[0][0])
+	}
+	t.Log("✓ IEEE_DIVIDE(1, 0) returned +inf")
+
+	t.Log("=== Numeric semantics test completed successfully! ===")
+}
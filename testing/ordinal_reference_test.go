@@ -0,0 +1,151 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestOrdinalReference(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing ordinal GROUP BY and ORDER BY with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating initial table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING,
+    age INT64,
+    status STRING
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Insert test data
+	t.Log("5. Inserting test data...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name, age, status)
+VALUES
+    (1, 'Alice', 25, 'active'),
+    (2, 'Bob', 30, 'inactive'),
+    (3, 'Charlie', 35, 'active'),
+    (4, 'David', 40, 'inactive'),
+    (5, 'Eve', 28, 'active')`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// readRows returns the rows of a query as a comparable string
+	readRows := func(querySQL string) string {
+		t.Helper()
+		it, err := client.Query(querySQL).Read(ctx)
+		if err != nil {
+			t.Fatalf("Failed to query %q: %v", querySQL, err)
+		}
+		var rows [][]bigquery.Value
+		for {
+			var row []bigquery.Value
+			if err := it.Next(&row); err != nil {
+				if err == iterator.Done {
+					break
+				}
+				t.Fatalf("Failed to read row: %v", err)
+			}
+			t.Logf("  %v", row)
+			rows = append(rows, row)
+		}
+		return fmt.Sprint(rows)
+	}
+
+	// Group and order by position
+	t.Log("6. Executing GROUP BY 1 ORDER BY 2 DESC...")
+	ordinalSQL := `SELECT status, COUNT(*) FROM ` + "`" + tableName + "`" + ` GROUP BY 1 ORDER BY 2 DESC`
+	ordinal := readRows(ordinalSQL)
+	want := fmt.Sprint([][]bigquery.Value{
+		{"active", int64(3)},
+		{"inactive", int64(2)},
+	})
+	if ordinal != want {
+		t.Fatalf("Ordinal query returned %s, want %s", ordinal, want)
+	}
+	t.Log("✓ Ordinal GROUP BY and ORDER BY returned expected groups")
+
+	// The named-column equivalent must match
+	t.Log("7. Executing the named-column equivalent...")
+	namedSQL := `SELECT status, COUNT(*) AS c FROM ` + "`" + tableName + "`" + ` GROUP BY status ORDER BY c DESC`
+	if named := readRows(namedSQL); named != ordinal {
+		t.Fatalf("Named query returned %s, ordinal query returned %s", named, ordinal)
+	}
+	t.Log("✓ Ordinal and named forms agree")
+
+	// An ordinal past the select list must fail
+	t.Log("8. Executing GROUP BY with an out-of-range ordinal...")
+	outOfRangeSQL := `SELECT status, COUNT(*) FROM ` + "`" + tableName + "`" + ` GROUP BY 5`
+	if _, err := client.Query(outOfRangeSQL).Read(ctx); err == nil {
+		t.Fatalf("GROUP BY 5 should fail with only 2 select items, but query succeeded")
+	} else {
+		t.Logf("✓ Out-of-range ordinal failed (error: %v)", err)
+	}
+
+	t.Log("=== ordinal GROUP BY and ORDER BY test completed successfully! ===")
+}
@@ -0,0 +1,55 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParseDateAndTimestamp(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+
+	t.Log("=== Testing PARSE_DATE and PARSE_TIMESTAMP with BigQuery Emulator ===")
+
+	// PARSE_DATE returns a DATE, which the client reads as civil.Date
+	t.Log("1. Evaluating PARSE_DATE...")
+	rows := h.Query(t, "SELECT PARSE_DATE('%Y-%m-%d', '2024-01-15')")
+	if len(rows) != 1 {
+		t.Fatalf("PARSE_DATE returned %d rows, want 1", len(rows))
+	}
+	if got := fmt.Sprintf("%T %v", rows[0][0], rows[0][0]); got != "civil.Date 2024-01-15" {
+		t.Fatalf("PARSE_DATE returned %s, want civil.Date 2024-01-15", got)
+	}
+	t.Log("✓ PARSE_DATE returned 2024-01-15")
+
+	// PARSE_TIMESTAMP returns a TIMESTAMP, read as time.Time in UTC
+	t.Log("2. Evaluating PARSE_TIMESTAMP...")
+	rows = h.Query(t, "SELECT PARSE_TIMESTAMP('%Y-%m-%d %H:%M:%S', '2024-01-15 10:30:00')")
+	if len(rows) != 1 {
+		t.Fatalf("PARSE_TIMESTAMP returned %d rows, want 1", len(rows))
+	}
+	want := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	if got, ok := rows[0][0].(time.Time); !ok || !got.Equal(want) {
+		t.Fatalf("PARSE_TIMESTAMP returned %T %v, want %v", rows[0][0], rows[0][0], want)
+	}
+	t.Log("✓ PARSE_TIMESTAMP returned 2024-01-15 10:30:00 UTC")
+
+	// Input that does not match the format is an error, not NULL
+	t.Log("3. Evaluating malformed inputs...")
+	for _, sql := range []string{
+		"SELECT PARSE_DATE('%Y-%m-%d', '2024/01/15')",
+		"SELECT PARSE_DATE('%Y-%m-%d', '2024-13-40')",
+		"SELECT PARSE_TIMESTAMP('%Y-%m-%d %H:%M:%S', 'not a timestamp')",
+	} {
+		err := Exec(ctx, h.Client, sql)
+		if err == nil {
+			t.Fatalf("%s succeeded, want a parse error", sql)
+		}
+		t.Logf("  %s -> %v", sql, err)
+	}
+	t.Log("✓ Malformed inputs are rejected")
+
+	t.Log("=== PARSE_DATE and PARSE_TIMESTAMP test completed successfully! ===")
+}
@@ -0,0 +1,170 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestPivotUnpivot(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "sales"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing PIVOT and UNPIVOT with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating sales table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    product STRING,
+    quarter STRING,
+    amount INT64
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Insert test data
+	t.Log("5. Inserting test data...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (product, quarter, amount)
+VALUES ('Kale', 'Q1', 10), ('Kale', 'Q1', 5), ('Kale', 'Q2', 20), ('Apple', 'Q1', 7)`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// Pivot quarters into columns, including a quarter with no rows
+	t.Log("6. Executing PIVOT...")
+	pivotSQL := `
+SELECT * FROM ` + "`" + tableName + "`" + `
+PIVOT (SUM(amount) FOR quarter IN ('Q1', 'Q2', 'Q3'))
+ORDER BY product`
+	it, err := client.Query(pivotSQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query with PIVOT: %v", err)
+	}
+	var pivotRows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		t.Logf("  %v", row)
+		pivotRows = append(pivotRows, row)
+	}
+	var columns []string
+	for _, field := range it.Schema {
+		columns = append(columns, field.Name)
+	}
+	if got, want := strings.Join(columns, ","), "product,Q1,Q2,Q3"; got != want {
+		t.Fatalf("PIVOT returned columns %s, want %s", got, want)
+	}
+	want := [][]bigquery.Value{
+		{"Apple", int64(7), nil, nil},
+		{"Kale", int64(15), int64(20), nil},
+	}
+	if fmt.Sprint(pivotRows) != fmt.Sprint(want) {
+		t.Fatalf("PIVOT returned %v, want %v", pivotRows, want)
+	}
+	t.Log("✓ PIVOT reshaped rows into quarter columns, with NULL for Q3")
+
+	// Unpivot the quarter columns back into rows
+	t.Log("7. Executing UNPIVOT...")
+	unpivotSQL := `
+SELECT product, quarter, amount FROM (
+    SELECT * FROM ` + "`" + tableName + "`" + `
+    PIVOT (SUM(amount) FOR quarter IN ('Q1', 'Q2'))
+)
+UNPIVOT (amount FOR quarter IN (Q1, Q2))
+ORDER BY product, quarter`
+	it, err = client.Query(unpivotSQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query with UNPIVOT: %v", err)
+	}
+	var unpivotRows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		unpivotRows = append(unpivotRows, row)
+	}
+	for _, row := range unpivotRows {
+		t.Logf("  Product: %v, Quarter: %v, Amount: %v", row[0], row[1], row[2])
+	}
+	// UNPIVOT excludes NULL values by default, so Apple has no Q2 row
+	wantUnpivot := [][]bigquery.Value{
+		{"Apple", "Q1", int64(7)},
+		{"Kale", "Q1", int64(15)},
+		{"Kale", "Q2", int64(20)},
+	}
+	if fmt.Sprint(unpivotRows) != fmt.Sprint(wantUnpivot) {
+		t.Fatalf("UNPIVOT returned %v, want %v", unpivotRows, wantUnpivot)
+	}
+	t.Log("✓ UNPIVOT reshaped quarter columns back into rows")
+
+	t.Log("=== PIVOT and UNPIVOT test completed successfully! ===")
+}
@@ -0,0 +1,159 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestQualify(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing QUALIFY with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating initial table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING,
+    age INT64,
+    status STRING
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Insert test data
+	t.Log("5. Inserting test data...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name, age, status)
+VALUES
+    (1, 'Alice', 25, 'active'),
+    (2, 'Bob', 30, 'inactive'),
+    (3, 'Charlie', 35, 'active'),
+    (4, 'David', 40, 'inactive'),
+    (5, 'Eve', 28, 'active')`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// Execute a QUALIFY query returning the first row per status
+	t.Log("6. Executing SELECT with QUALIFY...")
+	qualifySQL := `
+SELECT id, name, status, ROW_NUMBER() OVER (PARTITION BY status ORDER BY id) AS rn
+FROM ` + "`" + tableName + "`" + `
+QUALIFY rn = 1
+ORDER BY status`
+	it, err := client.Query(qualifySQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query with QUALIFY: %v", err)
+	}
+	var rows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	for _, row := range rows {
+		t.Logf("  ID: %v, Name: %v, Status: %v, RN: %v", row[0], row[1], row[2], row[3])
+	}
+	if len(rows) != 2 || rows[0][0] != int64(1) || rows[0][2] != "active" || rows[1][0] != int64(2) || rows[1][2] != "inactive" {
+		t.Fatalf("QUALIFY returned %v, want id 1 (active) and id 2 (inactive)", rows)
+	}
+	t.Log("✓ QUALIFY returned the first row per status")
+
+	// Combine QUALIFY with WHERE, which filters before the window is evaluated
+	t.Log("7. Executing SELECT with WHERE and QUALIFY...")
+	whereSQL := `
+SELECT id, name, status, ROW_NUMBER() OVER (PARTITION BY status ORDER BY id) AS rn
+FROM ` + "`" + tableName + "`" + `
+WHERE age > 26
+QUALIFY rn = 1
+ORDER BY status`
+	it, err = client.Query(whereSQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query with WHERE and QUALIFY: %v", err)
+	}
+	var whereRows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		whereRows = append(whereRows, row)
+	}
+	for _, row := range whereRows {
+		t.Logf("  ID: %v, Name: %v, Status: %v, RN: %v", row[0], row[1], row[2], row[3])
+	}
+	if len(whereRows) != 2 || whereRows[0][0] != int64(3) || whereRows[1][0] != int64(2) {
+		t.Fatalf("WHERE + QUALIFY returned %v, want id 3 (active) and id 2 (inactive)", whereRows)
+	}
+	t.Log("✓ WHERE applied before QUALIFY")
+
+	t.Log("=== QUALIFY test completed successfully! ===")
+}
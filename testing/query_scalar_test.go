@@ -0,0 +1,60 @@
+package testing
+
+import (
+	"context"
+	"testing"
+)
+
+func TestQueryScalar(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing QueryScalar with BigQuery Emulator ===")
+
+	// Create and seed the table
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING, age INT64)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, name, age) VALUES (1, 'Alice', 30), (2, 'Bob', 42), (3, 'Charlie', 25)")
+	t.Log("✓ Table created and seeded")
+
+	// Typed scalars of different types
+	t.Log("2. Reading scalar values...")
+	maxAge, err := QueryScalar[int64](ctx, h.Client, "SELECT MAX(age) FROM "+tableName)
+	if err != nil {
+		t.Fatalf("Failed to query MAX(age): %v", err)
+	}
+	if maxAge != 42 {
+		t.Fatalf("MAX(age) is %d, want 42", maxAge)
+	}
+	oldest, err := QueryScalar[string](ctx, h.Client, "SELECT name FROM "+tableName+" ORDER BY age DESC LIMIT 1")
+	if err != nil {
+		t.Fatalf("Failed to query oldest name: %v", err)
+	}
+	if oldest != "Bob" {
+		t.Fatalf("Oldest user is %q, want %q", oldest, "Bob")
+	}
+	t.Logf("✓ MAX(age) is %d and the oldest user is %s", maxAge, oldest)
+
+	// Anything but one row with one non-NULL value of type T is an error
+	t.Log("3. Verifying non-scalar results are rejected...")
+	for _, tc := range []struct {
+		name string
+		sql  string
+	}{
+		{name: "multiple columns", sql: "SELECT MAX(age), MIN(age) FROM " + tableName},
+		{name: "multiple rows", sql: "SELECT age FROM " + tableName},
+		{name: "no rows", sql: "SELECT age FROM " + tableName + " WHERE FALSE"},
+		{name: "NULL value", sql: "SELECT MAX(age) FROM " + tableName + " WHERE FALSE"},
+		{name: "wrong type", sql: "SELECT MAX(name) FROM " + tableName},
+	} {
+		if _, err := QueryScalar[int64](ctx, h.Client, tc.sql); err == nil {
+			t.Fatalf("QueryScalar with %s succeeded, want an error", tc.name)
+		} else {
+			t.Logf("  %s -> %v", tc.name, err)
+		}
+	}
+	t.Log("✓ Non-scalar results rejected")
+
+	t.Log("=== QueryScalar test completed successfully! ===")
+}
@@ -0,0 +1,86 @@
+package testing
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WithRequestLogsOnFailure records every request the harness client sends to
+// the emulator, with its status and, for failed requests, the response body.
+// The records are buffered and written to t.Log only if the test fails, so
+// passing tests stay quiet.
+//
+// Only the client side of each request is recorded. Buffering the emulator's
+// own log output is not supported, because the server has no way to redirect
+// its logger; it keeps writing to stderr.
+func WithRequestLogsOnFailure() HarnessOption {
+	return func(h *Harness) {
+		h.requestLogs = &logBuffer{}
+	}
+}
+
+// logBuffer collects log lines until the end of the test.
+type logBuffer struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.lines = append(b.lines, strings.TrimSuffix(string(p), "\n"))
+	return len(p), nil
+}
+
+// flush passes the buffered lines to log if failed is true and drops them
+// otherwise.
+func (b *logBuffer) flush(failed bool, log func(args ...any)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if failed {
+		log("=== Emulator request log ===")
+		for _, line := range b.lines {
+			log(line)
+		}
+	}
+	b.lines = nil
+}
+
+// loggingTransport logs each round trip to the emulator.
+type loggingTransport struct {
+	base   http.RoundTripper
+	logger *slog.Logger
+}
+
+func (lt *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := lt.base.RoundTrip(req)
+	attrs := []any{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Duration("duration", time.Since(start)),
+	}
+	if err != nil {
+		lt.logger.Error("server request failed", append(attrs, slog.Any("error", err))...)
+		return resp, err
+	}
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	if resp.StatusCode < http.StatusBadRequest {
+		lt.logger.Info("server request", attrs...)
+		return resp, nil
+	}
+	// Keep the error body for the log and hand the client an unread copy
+	body, readErr := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		attrs = append(attrs, slog.Any("error", readErr))
+	}
+	lt.logger.Error("server request", append(attrs, slog.String("body", string(body)))...)
+	return resp, nil
+}
@@ -0,0 +1,81 @@
+package testing
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// requestLogsModeEnv selects how TestRequestLogsHelper ends when it is run
+// by TestRequestLogsOnFailure in a child process.
+const requestLogsModeEnv = "BQE_REQUEST_LOGS_MODE"
+
+// TestRequestLogsHelper runs a failing query under WithRequestLogsOnFailure
+// and then passes or fails, so the harness cleanup can be observed from a
+// separate process. It is skipped in a normal test run.
+func TestRequestLogsHelper(t *testing.T) {
+	mode := os.Getenv(requestLogsModeEnv)
+	if mode == "" {
+		t.Skip("only run by TestRequestLogsOnFailure")
+	}
+	ctx := context.Background()
+	h := NewHarness(t, WithRequestLogsOnFailure())
+	tableName := h.TableName("users")
+
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64)")
+	if err := Exec(ctx, h.Client, "SELECT missing_column FROM "+tableName); err == nil {
+		t.Fatal("Query of a missing column succeeded, want an error")
+	}
+	if mode == "fail" {
+		t.Fatal("deliberate failure")
+	}
+}
+
+func TestRequestLogsOnFailure(t *testing.T) {
+	t.Log("=== Testing request logs on failure with BigQuery Emulator ===")
+
+	// runHelper runs TestRequestLogsHelper in a child test binary and returns
+	// its output and whether it passed
+	runHelper := func(mode string) (string, bool) {
+		t.Helper()
+		cmd := exec.Command(os.Args[0], "-test.run=^TestRequestLogsHelper$", "-test.v")
+		cmd.Env = append(os.Environ(), requestLogsModeEnv+"="+mode)
+		out, err := cmd.CombinedOutput()
+		if _, ok := err.(*exec.ExitError); err != nil && !ok {
+			t.Fatalf("Failed to run helper test: %v", err)
+		}
+		return string(out), err == nil
+	}
+
+	// A failed test gets every buffered request, including the error body
+	t.Log("1. Running a failing test with request logs enabled...")
+	output, passed := runHelper("fail")
+	if passed {
+		t.Fatalf("Helper test passed, want a failure:\n%s", output)
+	}
+	if !strings.Contains(output, "=== Emulator request log ===") {
+		t.Fatalf("Failing test output has no request log:\n%s", output)
+	}
+	if !strings.Contains(output, "server request") {
+		t.Fatalf("Failing test output has no recorded requests:\n%s", output)
+	}
+	if !strings.Contains(output, "missing_column") {
+		t.Fatalf("Failing test output does not include the failing request's error body:\n%s", output)
+	}
+	t.Log("✓ Buffered request logs surfaced on failure")
+
+	// A passing test shows nothing, even though one of its requests failed
+	t.Log("2. Running a passing test with request logs enabled...")
+	output, passed = runHelper("pass")
+	if !passed {
+		t.Fatalf("Helper test failed, want a pass:\n%s", output)
+	}
+	if strings.Contains(output, "=== Emulator request log ===") {
+		t.Fatalf("Passing test output includes the request log:\n%s", output)
+	}
+	t.Log("✓ Request logs suppressed on success")
+
+	t.Log("=== Request logs on failure test completed successfully! ===")
+}
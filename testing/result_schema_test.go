@@ -0,0 +1,57 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+)
+
+func TestAssertResultSchema(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing result column types with BigQuery Emulator ===")
+
+	// Create and seed the table
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, age FLOAT64, balance NUMERIC, name STRING)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, age, balance, name) VALUES (1, 30.5, 10, 'Alice')")
+	t.Log("✓ Table created and seeded")
+
+	// Arithmetic keeps the wider operand type
+	t.Log("2. Verifying arithmetic result types...")
+	AssertResultSchema(t, ctx, h.Client,
+		"SELECT id + 1, age + 1, balance + 1, id / 2, id * age, CONCAT(name, '!') FROM "+tableName,
+		[]bigquery.FieldType{
+			bigquery.IntegerFieldType,
+			bigquery.FloatFieldType,
+			bigquery.NumericFieldType,
+			// Division always returns FLOAT64
+			bigquery.FloatFieldType,
+			bigquery.FloatFieldType,
+			bigquery.StringFieldType,
+		})
+	t.Log("✓ Arithmetic result types match")
+
+	// CASE takes the common supertype of its branches
+	t.Log("3. Verifying CASE result types...")
+	AssertResultSchema(t, ctx, h.Client,
+		"SELECT CASE WHEN id > 0 THEN id ELSE age END, CASE WHEN id > 0 THEN id ELSE balance END, CASE WHEN id > 0 THEN name END FROM "+tableName,
+		[]bigquery.FieldType{
+			bigquery.FloatFieldType,
+			bigquery.NumericFieldType,
+			bigquery.StringFieldType,
+		})
+	t.Log("✓ CASE result types match")
+
+	// The schema is reported even when no rows are returned
+	t.Log("4. Verifying result types of an empty result...")
+	AssertResultSchema(t, ctx, h.Client,
+		"SELECT id, age FROM "+tableName+" WHERE FALSE",
+		[]bigquery.FieldType{bigquery.IntegerFieldType, bigquery.FloatFieldType})
+	t.Log("✓ Empty result types match")
+
+	t.Log("=== Result column type test completed successfully! ===")
+}
@@ -0,0 +1,54 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+// The harness client is unauthenticated, so it is only covered by policies
+// granted to allUsers. As in BigQuery, once a table has any row access policy
+// a caller outside every grantee list sees no rows at all.
+func TestRowAccessPolicy(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+	selectSQL := "SELECT id FROM " + tableName + " ORDER BY id"
+
+	t.Log("=== Testing row access policies with BigQuery Emulator ===")
+
+	// Create and seed the table
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, status STRING)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, status) VALUES (1, 'active'), (2, 'inactive'), (3, 'active')")
+	t.Log("✓ Table created and seeded")
+
+	for i, tc := range []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{
+			name: "policy for another principal",
+			sql:  "CREATE ROW ACCESS POLICY alice_active ON " + tableName + " GRANT TO ('user:alice@example.com') FILTER USING (status = 'active')",
+			want: "[]",
+		},
+		{
+			name: "policy for allUsers",
+			sql:  "CREATE ROW ACCESS POLICY everyone_active ON " + tableName + " GRANT TO ('allUsers') FILTER USING (status = 'active')",
+			want: "[[1] [3]]",
+		},
+		{
+			name: "DROP ALL ROW ACCESS POLICIES",
+			sql:  "DROP ALL ROW ACCESS POLICIES ON " + tableName,
+			want: "[[1] [2] [3]]",
+		},
+	} {
+		t.Logf("%d. Applying %s...", i+2, tc.name)
+		h.Exec(t, tc.sql)
+		if got := fmt.Sprint(h.Query(t, selectSQL)); got != tc.want {
+			t.Fatalf("After %s the caller sees %s, want %s", tc.name, got, tc.want)
+		}
+		t.Logf("✓ Caller sees %s", tc.want)
+	}
+
+	t.Log("=== Row access policy test completed successfully! ===")
+}
@@ -0,0 +1,48 @@
+package testing
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRowCount(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing RowCount with BigQuery Emulator ===")
+
+	// A freshly created table has no rows
+	t.Log("1. Counting rows in an empty table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING)")
+	count, err := RowCount(ctx, h.Client, h.DatasetID, "users")
+	if err != nil {
+		t.Fatalf("Failed to count rows: %v", err)
+	}
+	if count != 0 {
+		t.Fatalf("Empty table has %d rows, want 0", count)
+	}
+	t.Log("✓ Empty table has 0 rows")
+
+	// The count follows inserted rows
+	t.Log("2. Counting rows after insert...")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, name) VALUES (1, 'Alice'), (2, 'Bob'), (3, 'Charlie')")
+	count, err = RowCount(ctx, h.Client, h.DatasetID, "users")
+	if err != nil {
+		t.Fatalf("Failed to count rows: %v", err)
+	}
+	if count != 3 {
+		t.Fatalf("Table has %d rows, want 3", count)
+	}
+	t.Log("✓ Table has 3 rows")
+
+	// A missing table is an error rather than a zero count
+	t.Log("3. Counting rows in a missing table...")
+	if _, err := RowCount(ctx, h.Client, h.DatasetID, "missing"); err == nil {
+		t.Fatal("RowCount of a missing table succeeded, want an error")
+	} else {
+		t.Logf("✓ Missing table returned error: %v", err)
+	}
+
+	t.Log("=== RowCount test completed successfully! ===")
+}
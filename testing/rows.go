@@ -0,0 +1,86 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/iterator"
+)
+
+// QueryRowsByName runs a query and returns each row keyed by column name,
+// using the result schema reported by the iterator. Rows of a query without
+// results yield an empty, non-nil slice. A result with two columns of the same
+// name, such as SELECT a.id, b.id, is an error; alias them apart instead.
+func QueryRowsByName(ctx context.Context, client *bigquery.Client, sql string) ([]map[string]bigquery.Value, error) {
+	it, err := client.Query(sql).Read(ctx)
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	rows := []map[string]bigquery.Value{}
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, classifyError(err)
+		}
+		// The schema is only guaranteed once the first page has been fetched
+		if len(it.Schema) != len(row) {
+			return nil, fmt.Errorf("result schema has %d columns but row has %d values", len(it.Schema), len(row))
+		}
+		if len(rows) == 0 {
+			seen := make(map[string]bool, len(it.Schema))
+			for _, field := range it.Schema {
+				if seen[field.Name] {
+					return nil, fmt.Errorf("result has more than one column named %s", field.Name)
+				}
+				seen[field.Name] = true
+			}
+		}
+		named := make(map[string]bigquery.Value, len(row))
+		for i, field := range it.Schema {
+			named[field.Name] = row[i]
+		}
+		rows = append(rows, named)
+	}
+	return rows, nil
+}
+
+// QueryScalar runs a query that must return exactly one row with one column
+// and returns that value as T. A NULL result, or a value of another type, is
+// an error.
+func QueryScalar[T any](ctx context.Context, client *bigquery.Client, sql string) (T, error) {
+	var zero T
+	it, err := client.Query(sql).Read(ctx)
+	if err != nil {
+		return zero, classifyError(err)
+	}
+	// Reading stops at the second row, so a large result is not drained
+	var rows [][]bigquery.Value
+	for len(rows) < 2 {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return zero, classifyError(err)
+		}
+		rows = append(rows, row)
+	}
+	switch len(rows) {
+	case 0:
+		return zero, fmt.Errorf("scalar query returned no rows, want 1")
+	case 2:
+		return zero, fmt.Errorf("scalar query returned more than 1 row, want 1")
+	}
+	if len(rows[0]) != 1 {
+		return zero, fmt.Errorf("scalar query returned %d columns, want 1", len(rows[0]))
+	}
+	value, ok := rows[0][0].(T)
+	if !ok {
+		return zero, fmt.Errorf("scalar query returned %T, want %T", rows[0][0], zero)
+	}
+	return value, nil
+}
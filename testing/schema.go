@@ -0,0 +1,126 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/iterator"
+)
+
+// SnapshotSchemas returns the schema of every table in the dataset keyed by
+// table ID. An empty dataset yields an empty, non-nil map.
+func SnapshotSchemas(ctx context.Context, client *bigquery.Client, dataset string) (map[string]bigquery.Schema, error) {
+	schemas := make(map[string]bigquery.Schema)
+	tables := client.Dataset(dataset).Tables(ctx)
+	for {
+		table, err := tables.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, fmt.Errorf("failed to list tables in %s: %w", dataset, err)
+		}
+		meta, err := table.Metadata(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metadata for %s.%s: %w", dataset, table.TableID, err)
+		}
+		schemas[table.TableID] = meta.Schema
+	}
+	return schemas, nil
+}
+
+// DiffSchemas returns the ALTER TABLE statements that take table from the
+// current schema to the desired one: added and dropped columns, type changes
+// and relaxed NOT NULL constraints. Columns are matched by name at the top
+// level; a STRUCT column is compared by its whole type, so a changed member
+// yields a SET DATA TYPE on the STRUCT. Type parameters such as STRING(10) and
+// NUMERIC(10, 2) are part of the type. The statements are not checked against
+// BigQuery's allowed type widenings. Identical schemas yield an empty slice.
+//
+// The table name is taken as an argument because every statement has to name
+// it. Changes that no ALTER TABLE statement can make, such as adding a NOT
+// NULL column, making an existing column NOT NULL or switching a column to or
+// from an ARRAY, return an error instead of a partial migration.
+func DiffSchemas(table string, current, desired bigquery.Schema) ([]string, error) {
+	prefix := "ALTER TABLE `" + table + "` "
+	existing := make(map[string]*bigquery.FieldSchema, len(current))
+	for _, field := range current {
+		existing[field.Name] = field
+	}
+	wanted := make(map[string]bool, len(desired))
+
+	statements := []string{}
+	for _, field := range desired {
+		wanted[field.Name] = true
+		old, ok := existing[field.Name]
+		if !ok {
+			if field.Required {
+				return nil, fmt.Errorf("cannot add NOT NULL column %s to %s", field.Name, table)
+			}
+			statements = append(statements, prefix+"ADD COLUMN `"+field.Name+"` "+sqlType(field))
+			continue
+		}
+		if old.Repeated != field.Repeated {
+			return nil, fmt.Errorf("cannot change column %s of %s from %s to %s", field.Name, table, fieldMode(old), fieldMode(field))
+		}
+		if !old.Required && field.Required {
+			return nil, fmt.Errorf("cannot make column %s of %s NOT NULL", field.Name, table)
+		}
+		if sqlType(old) != sqlType(field) {
+			statements = append(statements, prefix+"ALTER COLUMN `"+field.Name+"` SET DATA TYPE "+sqlType(field))
+		}
+		if old.Required && !field.Required {
+			statements = append(statements, prefix+"ALTER COLUMN `"+field.Name+"` DROP NOT NULL")
+		}
+	}
+	for _, field := range current {
+		if !wanted[field.Name] {
+			statements = append(statements, prefix+"DROP COLUMN `"+field.Name+"`")
+		}
+	}
+	return statements, nil
+}
+
+// sqlTypeNames maps the legacy type names used in table metadata to GoogleSQL.
+var sqlTypeNames = map[bigquery.FieldType]string{
+	bigquery.IntegerFieldType: "INT64",
+	bigquery.FloatFieldType:   "FLOAT64",
+	bigquery.BooleanFieldType: "BOOL",
+}
+
+// sqlType returns the GoogleSQL type of a field, including type parameters
+// and ARRAY, STRUCT and RANGE wrappers, without its NOT NULL constraint.
+func sqlType(field *bigquery.FieldSchema) string {
+	name, ok := sqlTypeNames[field.Type]
+	if !ok {
+		name = string(field.Type)
+	}
+	switch field.Type {
+	case bigquery.StringFieldType, bigquery.BytesFieldType:
+		if field.MaxLength > 0 {
+			name += "(" + strconv.FormatInt(field.MaxLength, 10) + ")"
+		}
+	case bigquery.NumericFieldType, bigquery.BigNumericFieldType:
+		if field.Precision > 0 {
+			name += "(" + strconv.FormatInt(field.Precision, 10) + ", " + strconv.FormatInt(field.Scale, 10) + ")"
+		}
+	case bigquery.RangeFieldType:
+		if field.RangeElementType != nil {
+			name = "RANGE<" + string(field.RangeElementType.Type) + ">"
+		}
+	}
+	if field.Type == bigquery.RecordFieldType {
+		var members []string
+		for _, member := range field.Schema {
+			members = append(members, "`"+member.Name+"` "+sqlType(member))
+		}
+		name = "STRUCT<" + strings.Join(members, ", ") + ">"
+	}
+	if field.Repeated {
+		return "ARRAY<" + name + ">"
+	}
+	return name
+}
@@ -0,0 +1,70 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestScriptControlFlow(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("events")
+
+	t.Log("=== Testing IF, WHILE and LOOP in scripts with BigQuery Emulator ===")
+
+	// Create the table
+	t.Log("1. Creating table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, source STRING)")
+	t.Log("✓ Table created successfully")
+
+	// The IF branch runs only while the table is empty
+	ifScript := `
+IF (SELECT COUNT(*) FROM ` + tableName + `) = 0 THEN
+  INSERT INTO ` + tableName + ` (id, source) VALUES (0, 'if');
+ELSE
+  INSERT INTO ` + tableName + ` (id, source) VALUES (-1, 'else');
+END IF;`
+	t.Log("2. Running IF script on the empty table...")
+	h.Exec(t, ifScript)
+	if got, want := fmt.Sprint(h.Query(t, "SELECT id, source FROM "+tableName+" ORDER BY id")), "[[0 if]]"; got != want {
+		t.Fatalf("Table contains %s after first IF, want %s", got, want)
+	}
+	t.Log("✓ THEN branch taken")
+
+	t.Log("3. Running IF script on the populated table...")
+	h.Exec(t, ifScript)
+	if got, want := fmt.Sprint(h.Query(t, "SELECT id, source FROM "+tableName+" ORDER BY id")), "[[-1 else] [0 if]]"; got != want {
+		t.Fatalf("Table contains %s after second IF, want %s", got, want)
+	}
+	t.Log("✓ ELSE branch taken")
+
+	// A WHILE loop driven by a counter inserts one row per iteration
+	t.Log("4. Running WHILE loop inserting 3 rows...")
+	h.Exec(t, `
+DECLARE i INT64 DEFAULT 1;
+WHILE i <= 3 DO
+  INSERT INTO `+tableName+` (id, source) VALUES (i, 'while');
+  SET i = i + 1;
+END WHILE;`)
+	if got, want := fmt.Sprint(h.Query(t, "SELECT id FROM "+tableName+" WHERE source = 'while' ORDER BY id")), "[[1] [2] [3]]"; got != want {
+		t.Fatalf("WHILE inserted %s, want %s", got, want)
+	}
+	t.Log("✓ WHILE loop inserted ids 1 to 3")
+
+	// LOOP runs until BREAK
+	t.Log("5. Running LOOP with BREAK...")
+	h.Exec(t, `
+DECLARE i INT64 DEFAULT 10;
+LOOP
+  IF i > 12 THEN
+    BREAK;
+  END IF;
+  INSERT INTO `+tableName+` (id, source) VALUES (i, 'loop');
+  SET i = i + 1;
+END LOOP;`)
+	if got, want := fmt.Sprint(h.Query(t, "SELECT id FROM "+tableName+" WHERE source = 'loop' ORDER BY id")), "[[10] [11] [12]]"; got != want {
+		t.Fatalf("LOOP inserted %s, want %s", got, want)
+	}
+	t.Log("✓ LOOP stopped at BREAK after ids 10 to 12")
+
+	t.Log("=== Script control flow test completed successfully! ===")
+}
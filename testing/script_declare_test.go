@@ -0,0 +1,50 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestScriptDeclareArrayAndStruct(t *testing.T) {
+	h := NewHarness(t)
+
+	t.Log("=== Testing DECLARE of ARRAY and STRUCT variables with BigQuery Emulator ===")
+
+	for i, tc := range []struct {
+		name   string
+		script string
+		want   string
+	}{
+		{
+			name: "ARRAY variable",
+			script: `
+DECLARE arr ARRAY<INT64> DEFAULT [1, 2, 3];
+SELECT x FROM UNNEST(arr) AS x ORDER BY x;`,
+			want: "[[1] [2] [3]]",
+		},
+		{
+			name: "SET with ARRAY_CONCAT",
+			script: `
+DECLARE arr ARRAY<INT64> DEFAULT [1, 2, 3];
+SET arr = ARRAY_CONCAT(arr, [4]);
+SELECT x FROM UNNEST(arr) AS x WITH OFFSET AS off ORDER BY off;`,
+			want: "[[1] [2] [3] [4]]",
+		},
+		{
+			name: "STRUCT variable",
+			script: `
+DECLARE user STRUCT<id INT64, name STRING> DEFAULT (1, 'Alice');
+SELECT user.id, user.name;`,
+			want: "[[1 Alice]]",
+		},
+	} {
+		t.Logf("%d. Running script with %s...", i+1, tc.name)
+		rows := h.Query(t, tc.script)
+		if got := fmt.Sprint(rows); got != tc.want {
+			t.Fatalf("Script with %s returned %s, want %s", tc.name, got, tc.want)
+		}
+		t.Logf("✓ Script with %s returned %s", tc.name, tc.want)
+	}
+
+	t.Log("=== DECLARE ARRAY and STRUCT test completed successfully! ===")
+}
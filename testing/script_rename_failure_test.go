@@ -0,0 +1,91 @@
+package testing
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/api/iterator"
+)
+
+// BigQuery scripts are not transactions: DDL that ran before a failing
+// statement stays applied. The script's child jobs record which statement
+// failed.
+func TestScriptRenameThenFailure(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing RENAME TO in a failing script with BigQuery Emulator ===")
+
+	// Create and seed the table
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING)")
+	h.Exec(t, "INSERT INTO "+tableName+" (id, name) VALUES (1, 'Alice'), (2, 'Bob')")
+	t.Log("✓ Table created and seeded")
+
+	// Rename, then fail on the following statement
+	t.Log("2. Running script that renames then fails...")
+	job, err := h.Client.Query(`
+ALTER TABLE ` + tableName + ` RENAME TO users_v2;
+SELECT ERROR('migration step failed');`).Run(ctx)
+	if err != nil {
+		t.Fatalf("Failed to start script: %v", err)
+	}
+	status, err := job.Wait(ctx)
+	if err == nil {
+		err = status.Err()
+	}
+	if err == nil {
+		t.Fatal("Script succeeded, want an error from its second statement")
+	}
+	if !strings.Contains(err.Error(), "migration step failed") {
+		t.Fatalf("Script failed with %v, want the ERROR() message", err)
+	}
+	t.Logf("✓ Script failed: %v", err)
+
+	// The failing child job names the statement that failed
+	t.Log("3. Finding the failed statement among the script's child jobs...")
+	var failed []string
+	children := job.Children(ctx)
+	for {
+		child, err := children.Next()
+		if err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to list child jobs: %v", err)
+		}
+		if child.LastStatus() == nil || child.LastStatus().Err() == nil {
+			continue
+		}
+		config, err := child.Config()
+		if err != nil {
+			t.Fatalf("Failed to get child job config: %v", err)
+		}
+		if q, ok := config.(*bigquery.QueryConfig); ok {
+			failed = append(failed, strings.TrimSpace(q.Q))
+		}
+	}
+	if len(failed) != 1 || !strings.HasPrefix(failed[0], "SELECT ERROR(") {
+		t.Fatalf("Failed child statements are %q, want only the SELECT ERROR statement", failed)
+	}
+	t.Logf("✓ Failed statement recorded: %s", failed[0])
+
+	// The rename from the first statement was not rolled back
+	t.Log("4. Verifying the rename persisted...")
+	count, err := RowCount(ctx, h.Client, h.DatasetID, "users_v2")
+	if err != nil {
+		t.Fatalf("Failed to count rows in users_v2: %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("users_v2 has %d rows, want 2", count)
+	}
+	if _, err := h.Client.Dataset(h.DatasetID).Table("users").Metadata(ctx); err == nil {
+		t.Fatal("users still exists after the script renamed it")
+	}
+	t.Log("✓ users was renamed to users_v2 despite the later failure")
+
+	t.Log("=== RENAME TO in a failing script test completed successfully! ===")
+}
@@ -0,0 +1,52 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSelectDistinct(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing SELECT DISTINCT with BigQuery Emulator ===")
+
+	// Seed duplicate statuses and two fully duplicated rows
+	t.Log("1. Creating and seeding table with duplicates...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, status STRING)")
+	h.Exec(t, `INSERT INTO `+tableName+` (id, status) VALUES
+    (1, 'active'), (1, 'active'), (2, 'active'), (3, 'inactive'), (3, 'pending'), (4, NULL), (4, NULL)`)
+	t.Log("✓ Table created and seeded")
+
+	for i, tc := range []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{
+			// NULL is a single distinct value
+			name: "DISTINCT status",
+			sql:  "SELECT DISTINCT status FROM " + tableName + " ORDER BY status",
+			want: "[[<nil>] [active] [inactive] [pending]]",
+		},
+		{
+			name: "DISTINCT id, status",
+			sql:  "SELECT DISTINCT id, status FROM " + tableName + " ORDER BY id, status",
+			want: "[[1 active] [2 active] [3 inactive] [3 pending] [4 <nil>]]",
+		},
+		{
+			name: "DISTINCT *",
+			sql:  "SELECT DISTINCT * FROM " + tableName + " ORDER BY id, status",
+			want: "[[1 active] [2 active] [3 inactive] [3 pending] [4 <nil>]]",
+		},
+	} {
+		t.Logf("%d. Querying %s...", i+2, tc.name)
+		rows := h.Query(t, tc.sql)
+		if got := fmt.Sprint(rows); got != tc.want {
+			t.Fatalf("%s returned %s, want %s", tc.name, got, tc.want)
+		}
+		t.Logf("✓ %s returned %s", tc.name, tc.want)
+	}
+
+	t.Log("=== SELECT DISTINCT test completed successfully! ===")
+}
@@ -0,0 +1,160 @@
+package testing
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestSelectExceptReplace(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing SELECT * EXCEPT and REPLACE with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating initial table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING,
+    email STRING,
+    age INT64
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Insert test data
+	t.Log("5. Inserting test data...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name, email, age)
+VALUES (1, 'Alice', 'alice@example.com', 25), (2, 'Bob', 'bob@example.com', 30)`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// Execute SELECT * EXCEPT
+	t.Log("6. Executing SELECT * EXCEPT (email)...")
+	exceptSQL := `SELECT * EXCEPT (email) FROM ` + "`" + tableName + "`" + ` ORDER BY id`
+	it, err := client.Query(exceptSQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query with EXCEPT: %v", err)
+	}
+	var exceptRows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		t.Logf("  %v", row)
+		exceptRows = append(exceptRows, row)
+	}
+	var columns []string
+	for _, field := range it.Schema {
+		columns = append(columns, field.Name)
+	}
+	if got, want := strings.Join(columns, ","), "id,name,age"; got != want {
+		t.Fatalf("EXCEPT returned columns %s, want %s", got, want)
+	}
+	if len(exceptRows) != 2 || exceptRows[0][1] != "Alice" || exceptRows[1][2] != int64(30) {
+		t.Fatalf("EXCEPT returned %v, want Alice and Bob without email", exceptRows)
+	}
+	t.Log("✓ email column excluded from results")
+
+	// Execute SELECT * REPLACE
+	t.Log("7. Executing SELECT * REPLACE (age + 1 AS age)...")
+	replaceSQL := `SELECT * REPLACE (age + 1 AS age) FROM ` + "`" + tableName + "`" + ` ORDER BY id`
+	it, err = client.Query(replaceSQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query with REPLACE: %v", err)
+	}
+	var replaceRows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		replaceRows = append(replaceRows, row)
+	}
+	for _, row := range replaceRows {
+		t.Logf("  %v", row)
+	}
+	if len(replaceRows) != 2 || replaceRows[0][3] != int64(26) || replaceRows[1][3] != int64(31) {
+		t.Fatalf("REPLACE returned %v, want ages 26 and 31", replaceRows)
+	}
+	t.Log("✓ age column replaced in place")
+
+	// EXCEPT of a column that does not exist must fail
+	t.Log("8. Executing SELECT * EXCEPT of a non-existent column...")
+	missingSQL := `SELECT * EXCEPT (missing) FROM ` + "`" + tableName + "`"
+	if _, err := client.Query(missingSQL).Read(ctx); err == nil {
+		t.Fatalf("EXCEPT of a non-existent column should fail, but query succeeded")
+	} else {
+		t.Logf("✓ EXCEPT of a non-existent column failed (error: %v)", err)
+	}
+
+	t.Log("=== SELECT * EXCEPT and REPLACE test completed successfully! ===")
+}
@@ -0,0 +1,58 @@
+package testing
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSnapshotSchemas(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+
+	t.Log("=== Testing SnapshotSchemas with BigQuery Emulator ===")
+
+	// Create three tables with different shapes
+	t.Log("1. Creating three tables...")
+	h.Exec(t, "CREATE TABLE "+h.TableName("users")+" (id INT64, name STRING, email STRING)")
+	h.Exec(t, "CREATE TABLE "+h.TableName("orders")+" (id INT64, user_id INT64)")
+	h.Exec(t, "CREATE TABLE "+h.TableName("events")+" (ts TIMESTAMP)")
+	t.Log("✓ Tables created successfully")
+
+	// Snapshot the whole dataset
+	t.Log("2. Snapshotting dataset schemas...")
+	schemas, err := SnapshotSchemas(ctx, h.Client, h.DatasetID)
+	if err != nil {
+		t.Fatalf("Failed to snapshot schemas: %v", err)
+	}
+	want := map[string]int{"users": 3, "orders": 2, "events": 1}
+	if len(schemas) != len(want) {
+		t.Fatalf("Snapshot has %d tables, want %d", len(schemas), len(want))
+	}
+	for table, fields := range want {
+		schema, ok := schemas[table]
+		if !ok {
+			t.Fatalf("Snapshot is missing table %s", table)
+		}
+		if len(schema) != fields {
+			t.Fatalf("Table %s has %d fields, want %d", table, len(schema), fields)
+		}
+		t.Logf("  %s: %d fields", table, len(schema))
+	}
+	t.Log("✓ Snapshot contains every table with its fields")
+
+	// An empty dataset returns an empty map
+	t.Log("3. Snapshotting an empty dataset...")
+	if err := h.Client.Dataset("empty").Create(ctx, nil); err != nil {
+		t.Fatalf("Failed to create empty dataset: %v", err)
+	}
+	schemas, err = SnapshotSchemas(ctx, h.Client, "empty")
+	if err != nil {
+		t.Fatalf("Failed to snapshot empty dataset: %v", err)
+	}
+	if schemas == nil || len(schemas) != 0 {
+		t.Fatalf("Empty dataset snapshot is %v, want an empty map", schemas)
+	}
+	t.Log("✓ Empty dataset returned an empty map")
+
+	t.Log("=== SnapshotSchemas test completed successfully! ===")
+}
@@ -0,0 +1,135 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestSQLComments(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing SQL comments with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table with leading and inline comments
+	t.Log("4. Creating table with SQL comments...")
+	createTableSQL := `
+-- Migration 001: create users table
+-- Owner: data platform
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64, /* surrogate key */
+    name STRING -- display name
+)`
+	t.Logf("Executing: %s", createTableSQL)
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Alter the table with a block comment containing a semicolon
+	t.Log("5. Executing ALTER TABLE with a semicolon inside a comment...")
+	alterSQL := `
+/* Migration 002; adds the age column; safe to re-run */
+ALTER TABLE ` + "`" + tableName + "`" + ` ADD COLUMN age INT64 -- nullable; backfilled later`
+	t.Logf("Executing: %s", alterSQL)
+	if err := Exec(ctx, client, alterSQL); err != nil {
+		t.Fatalf("Failed to execute ALTER TABLE: %v", err)
+	}
+	t.Log("✓ ALTER TABLE executed successfully")
+
+	// Insert with comments between the values
+	t.Log("6. Inserting data with SQL comments...")
+	insertSQL := `
+-- seed data
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name, age)
+VALUES
+    (1, 'Alice', 25), /* first user; active */
+    (2, 'Bob', 30) -- second user`
+	t.Logf("Executing: %s", insertSQL)
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// Verify the table shape and contents
+	t.Log("7. Verifying data...")
+	querySQL := `SELECT id, name, age FROM ` + "`" + tableName + "`" + ` ORDER BY id -- trailing comment`
+	it, err := client.Query(querySQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query table: %v", err)
+	}
+	var rows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	for _, row := range rows {
+		t.Logf("  ID: %v, Name: %v, Age: %v", row[0], row[1], row[2])
+	}
+	if len(rows) != 2 || rows[0][1] != "Alice" || rows[0][2] != int64(25) || rows[1][1] != "Bob" || rows[1][2] != int64(30) {
+		t.Fatalf("Rows are %v, want Alice(25) and Bob(30)", rows)
+	}
+	t.Log("✓ Commented statements executed as written")
+
+	t.Log("=== SQL comments test completed successfully! ===")
+}
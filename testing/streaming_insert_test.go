@@ -0,0 +1,158 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestStreamingInsert(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing streaming inserts with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating initial table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	table := client.Dataset(datasetID).Table(tableID)
+	meta, err := table.Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get table metadata: %v", err)
+	}
+
+	// Stream rows through tabledata.insertAll
+	t.Log("5. Streaming rows via the Inserter...")
+	rows := []*bigquery.ValuesSaver{
+		{Schema: meta.Schema, InsertID: "row-1", Row: []bigquery.Value{int64(1), "Alice"}},
+		{Schema: meta.Schema, InsertID: "row-2", Row: []bigquery.Value{int64(2), "Bob"}},
+	}
+	if err := table.Inserter().Put(ctx, rows); err != nil {
+		t.Fatalf("Failed to stream rows: %v", err)
+	}
+	t.Log("✓ Rows streamed successfully")
+
+	// Streamed rows must be queryable immediately
+	t.Log("6. Querying streamed rows...")
+	querySQL := `SELECT id, name FROM ` + "`" + tableName + "`" + ` ORDER BY id`
+	it, err := client.Query(querySQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query streamed rows: %v", err)
+	}
+	var streamed [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		streamed = append(streamed, row)
+	}
+	for _, row := range streamed {
+		t.Logf("  ID: %v, Name: %v", row[0], row[1])
+	}
+	if len(streamed) != 2 || streamed[0][1] != "Alice" || streamed[1][1] != "Bob" {
+		t.Fatalf("Streamed rows are %v, want Alice and Bob", streamed)
+	}
+	t.Log("✓ Streamed rows are queryable")
+
+	// Re-send a row with an insertId that was already used
+	t.Log("7. Streaming a duplicate insertId...")
+	duplicates := []*bigquery.ValuesSaver{
+		{Schema: meta.Schema, InsertID: "row-2", Row: []bigquery.Value{int64(2), "Bob"}},
+		{Schema: meta.Schema, InsertID: "row-3", Row: []bigquery.Value{int64(3), "Charlie"}},
+	}
+	if err := table.Inserter().Put(ctx, duplicates); err != nil {
+		t.Fatalf("Failed to stream duplicate rows: %v", err)
+	}
+	t.Log("✓ Duplicate rows streamed successfully")
+
+	// Verify the duplicate was collapsed
+	t.Log("8. Verifying duplicate insertId was collapsed...")
+	countSQL := `SELECT COUNT(*) FROM ` + "`" + tableName + "`"
+	it, err = client.Query(countSQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to count rows: %v", err)
+	}
+	var counts [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		counts = append(counts, row)
+	}
+	if len(counts) != 1 || counts[0][0] != int64(3) {
+		t.Fatalf("Row count is %v, want 3", counts)
+	}
+	t.Log("✓ Duplicate insertId collapsed into a single row")
+
+	t.Log("=== streaming inserts test completed successfully! ===")
+}
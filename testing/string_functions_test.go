@@ -0,0 +1,45 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStringFunctions(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("users")
+
+	t.Log("=== Testing string functions with BigQuery Emulator ===")
+
+	// Create and seed the table
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (id INT64, name STRING, email STRING)")
+	h.Exec(t, `INSERT INTO `+tableName+` (id, name, email) VALUES
+    (1, 'Alice', 'alice@example.com'),
+    (2, 'Bob', 'bob@example.org')`)
+	t.Log("✓ Table created and seeded")
+
+	for i, tc := range []struct {
+		expr string
+		want string
+	}{
+		{expr: "CONCAT(name, '!')", want: "[Alice! Bob!]"},
+		{expr: "SUBSTR(name, 1, 2)", want: "[Al Bo]"},
+		{expr: `REGEXP_CONTAINS(email, r'@example\.com$')`, want: "[true false]"},
+		{expr: "FORMAT('%d-%s', id, name)", want: "[1-Alice 2-Bob]"},
+		{expr: `REGEXP_EXTRACT(email, r'@([a-z]+)\.')`, want: "[example example]"},
+		{expr: `REGEXP_EXTRACT(email, r'\.([a-z]+)$')`, want: "[com org]"},
+	} {
+		t.Logf("%d. Evaluating %s...", i+2, tc.expr)
+		var values []any
+		for _, row := range h.Query(t, "SELECT "+tc.expr+" FROM "+tableName+" ORDER BY id") {
+			values = append(values, row[0])
+		}
+		if got := fmt.Sprint(values); got != tc.want {
+			t.Fatalf("%s returned %s, want %s", tc.expr, got, tc.want)
+		}
+		t.Logf("✓ %s returned %s", tc.expr, tc.want)
+	}
+
+	t.Log("=== String functions test completed successfully! ===")
+}
@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestTableSample(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	const rowCount = 1000
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing TABLESAMPLE with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating initial table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING,
+    age INT64,
+    status STRING
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Insert enough rows for the sample size to be meaningful
+	t.Log("5. Inserting test data...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, name, age, status)
+SELECT n, CONCAT('user', CAST(n AS STRING)), 20 + MOD(n, 50), IF(MOD(n, 2) = 0, 'active', 'inactive')
+FROM UNNEST(GENERATE_ARRAY(1, ` + strconv.Itoa(rowCount) + `)) AS n`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// Sample half the table; the result size is probabilistic, so the bounds
+	// leave room for chance while still catching a sample of none or all
+	t.Log("6. Executing TABLESAMPLE SYSTEM (50 PERCENT)...")
+	sampleSQL := `SELECT id FROM ` + "`" + tableName + "`" + ` TABLESAMPLE SYSTEM (50 PERCENT)`
+	it, err := client.Query(sampleSQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query with TABLESAMPLE: %v", err)
+	}
+	var sampled [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		sampled = append(sampled, row)
+	}
+	t.Logf("  Sampled %d rows", len(sampled))
+	if len(sampled) < rowCount*35/100 || len(sampled) > rowCount*65/100 {
+		t.Fatalf("50 PERCENT sample returned %d of %d rows, want between 35%% and 65%%", len(sampled), rowCount)
+	}
+	t.Log("✓ 50 PERCENT sample returned about half the rows")
+
+	// Sampling everything must be deterministic
+	t.Log("7. Executing TABLESAMPLE SYSTEM (100 PERCENT)...")
+	fullSQL := `SELECT id FROM ` + "`" + tableName + "`" + ` TABLESAMPLE SYSTEM (100 PERCENT) ORDER BY id`
+	it, err = client.Query(fullSQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query with full TABLESAMPLE: %v", err)
+	}
+	var all [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		all = append(all, row)
+	}
+	if len(all) != rowCount {
+		t.Fatalf("100 PERCENT sample returned %d rows, want %d", len(all), rowCount)
+	}
+	for i, row := range all {
+		if row[0] != int64(i+1) {
+			t.Fatalf("100 PERCENT sample returned id %v at position %d, want %d", row[0], i, i+1)
+		}
+	}
+	t.Log("✓ 100 PERCENT sample returned all rows")
+
+	t.Log("=== TABLESAMPLE test completed successfully! ===")
+}
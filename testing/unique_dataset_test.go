@@ -0,0 +1,63 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestUniqueDataset(t *testing.T) {
+	ctx := context.Background()
+	h := NewHarness(t)
+
+	t.Log("=== Testing unique per-test datasets with BigQuery Emulator ===")
+
+	// Names share the prefix and never repeat
+	t.Log("1. Generating dataset names...")
+	first, second := UniqueDataset("scenario"), UniqueDataset("scenario")
+	if first == second {
+		t.Fatalf("UniqueDataset returned %s twice", first)
+	}
+	if !strings.HasPrefix(first, "scenario_") {
+		t.Fatalf("UniqueDataset returned %s, want prefix scenario_", first)
+	}
+	t.Logf("✓ Generated %s and %s", first, second)
+
+	// Each parallel sub-test creates a same-named table in its own dataset
+	t.Log("2. Running parallel sub-tests with their own datasets...")
+	created := make(chan string, 2)
+	t.Run("group", func(t *testing.T) {
+		for _, name := range []string{"alice", "bob"} {
+			name := name
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				dataset := UniqueDataset("parallel")
+				CreateDataset(t, ctx, h.Client, dataset)
+				created <- dataset
+
+				tableName := "`" + h.ProjectID + "." + dataset + ".users`"
+				h.Exec(t, "CREATE TABLE "+tableName+" (name STRING)")
+				h.Exec(t, "INSERT INTO "+tableName+" (name) VALUES ('"+name+"')")
+
+				// Only this sub-test's row is visible, whatever the other is doing
+				if got, want := fmt.Sprint(h.Query(t, "SELECT name FROM "+tableName)), "[["+name+"]]"; got != want {
+					t.Fatalf("Dataset %s contains %s, want %s", dataset, got, want)
+				}
+			})
+		}
+	})
+	close(created)
+	t.Log("✓ Sub-tests did not see each other's tables")
+
+	// Cleanup registered by CreateDataset has dropped both datasets
+	t.Log("3. Verifying datasets were dropped on cleanup...")
+	for dataset := range created {
+		if _, err := h.Client.Dataset(dataset).Metadata(ctx); err == nil {
+			t.Fatalf("Dataset %s still exists after its sub-test finished", dataset)
+		}
+	}
+	t.Log("✓ Unique datasets dropped by t.Cleanup")
+
+	t.Log("=== Unique dataset test completed successfully! ===")
+}
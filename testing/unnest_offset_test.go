@@ -0,0 +1,43 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestUnnestWithOffset(t *testing.T) {
+	h := NewHarness(t)
+
+	t.Log("=== Testing UNNEST WITH OFFSET with BigQuery Emulator ===")
+
+	for i, tc := range []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{
+			name: "array literal",
+			sql:  "SELECT value, off FROM UNNEST(['a', 'b', 'c']) AS value WITH OFFSET AS off ORDER BY off",
+			want: "[[a 0] [b 1] [c 2]]",
+		},
+		{
+			name: "empty array",
+			sql:  "SELECT value, off FROM UNNEST(ARRAY<STRING>[]) AS value WITH OFFSET AS off",
+			want: "[]",
+		},
+		{
+			name: "NULL array",
+			sql:  "SELECT value, off FROM UNNEST(CAST(NULL AS ARRAY<STRING>)) AS value WITH OFFSET AS off",
+			want: "[]",
+		},
+	} {
+		t.Logf("%d. Unnesting %s...", i+1, tc.name)
+		rows := h.Query(t, tc.sql)
+		if got := fmt.Sprint(rows); got != tc.want {
+			t.Fatalf("UNNEST of %s returned %s, want %s", tc.name, got, tc.want)
+		}
+		t.Logf("✓ UNNEST of %s returned %s", tc.name, tc.want)
+	}
+
+	t.Log("=== UNNEST WITH OFFSET test completed successfully! ===")
+}
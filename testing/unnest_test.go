@@ -0,0 +1,146 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+func TestUnnest(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "orders"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing UNNEST over ARRAY<STRUCT> with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create table with a repeated struct column
+	t.Log("4. Creating table with ARRAY<STRUCT> column...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    items ARRAY<STRUCT<sku STRING, qty INT64>>
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Insert a row with two items
+	t.Log("5. Inserting an order with two items...")
+	insertSQL := `
+INSERT INTO ` + "`" + tableName + "`" + ` (id, items)
+VALUES (1, [STRUCT('apple' AS sku, 3 AS qty), STRUCT('pear' AS sku, 5 AS qty)])`
+	if err := Exec(ctx, client, insertSQL); err != nil {
+		t.Fatalf("Failed to insert data: %v", err)
+	}
+	t.Log("✓ Data inserted successfully")
+
+	// Flatten the items with UNNEST
+	t.Log("6. Flattening items with UNNEST...")
+	querySQL := `SELECT id, sku, qty FROM ` + "`" + tableName + "`" + `, UNNEST(items) ORDER BY sku`
+	it, err := client.Query(querySQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query flattened items: %v", err)
+	}
+	var rows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		rows = append(rows, row)
+	}
+	for _, row := range rows {
+		t.Logf("  ID: %v, SKU: %v, Qty: %v", row[0], row[1], row[2])
+	}
+	if len(rows) != 2 {
+		t.Fatalf("UNNEST returned %d rows, want 2", len(rows))
+	}
+	if rows[0][1] != "apple" || rows[0][2] != int64(3) || rows[1][1] != "pear" || rows[1][2] != int64(5) {
+		t.Fatalf("UNNEST returned %v, want [apple 3] and [pear 5]", rows)
+	}
+	t.Log("✓ Items flattened into one row per element")
+
+	// Flatten the items with their position
+	t.Log("7. Flattening items with UNNEST WITH OFFSET...")
+	offsetSQL := `SELECT item.sku, pos FROM ` + "`" + tableName + "`" + `, UNNEST(items) AS item WITH OFFSET AS pos ORDER BY pos`
+	it, err = client.Query(offsetSQL).Read(ctx)
+	if err != nil {
+		t.Fatalf("Failed to query items with offset: %v", err)
+	}
+	var offsetRows [][]bigquery.Value
+	for {
+		var row []bigquery.Value
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			t.Fatalf("Failed to read row: %v", err)
+		}
+		offsetRows = append(offsetRows, row)
+	}
+	for _, row := range offsetRows {
+		t.Logf("  SKU: %v, Offset: %v", row[0], row[1])
+	}
+	if len(offsetRows) != 2 || offsetRows[0][0] != "apple" || offsetRows[0][1] != int64(0) || offsetRows[1][0] != "pear" || offsetRows[1][1] != int64(1) {
+		t.Fatalf("UNNEST WITH OFFSET returned %v, want [apple 0] and [pear 1]", offsetRows)
+	}
+	t.Log("✓ Offsets follow array order")
+
+	t.Log("=== UNNEST over ARRAY<STRUCT> test completed successfully! ===")
+}
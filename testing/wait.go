@@ -0,0 +1,65 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+)
+
+// waitPollInterval is how often WaitForRowCount re-counts the table.
+const waitPollInterval = 50 * time.Millisecond
+
+// WaitForRowCount polls SELECT COUNT(*) on the table until it returns want or
+// the timeout elapses. The returned error on timeout includes the last
+// observed count, or says no count was observed and wraps the last query error
+// if the table never became readable.
+func WaitForRowCount(ctx context.Context, client *bigquery.Client, dataset, table string, want int, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	querySQL := "SELECT COUNT(*) FROM `" + client.Project() + "." + dataset + "." + table + "`"
+	var (
+		last    int64 = -1
+		lastErr error
+	)
+	ticker := time.NewTicker(waitPollInterval)
+	defer ticker.Stop()
+	for {
+		count, err := countRows(ctx, client, querySQL)
+		if err == nil && count == int64(want) {
+			return nil
+		}
+		if err == nil {
+			last = count
+		} else if ctx.Err() == nil || lastErr == nil {
+			// Keep an earlier query error over the timeout's own cancellation
+			lastErr = err
+		}
+
+		select {
+		case <-ctx.Done():
+			if last < 0 {
+				return fmt.Errorf("table %s.%s did not reach %d rows within %s: no count observed: %w", dataset, table, want, timeout, lastErr)
+			}
+			return fmt.Errorf("table %s.%s did not reach %d rows within %s: last observed count was %d", dataset, table, want, timeout, last)
+		case <-ticker.C:
+		}
+	}
+}
+
+// RowCount returns the number of rows in dataset.table in the client's project.
+func RowCount(ctx context.Context, client *bigquery.Client, dataset, table string) (int64, error) {
+	querySQL := "SELECT COUNT(*) FROM `" + client.Project() + "." + dataset + "." + table + "`"
+	count, err := countRows(ctx, client, querySQL)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count rows in %s.%s: %w", dataset, table, err)
+	}
+	return count, nil
+}
+
+// countRows runs a single-value COUNT query and returns its result.
+func countRows(ctx context.Context, client *bigquery.Client, querySQL string) (int64, error) {
+	return QueryScalar[int64](ctx, client, querySQL)
+}
@@ -0,0 +1,132 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/goccy/bigquery-emulator/server"
+	"github.com/goccy/bigquery-emulator/types"
+	"google.golang.org/api/option"
+)
+
+func TestWaitForRowCount(t *testing.T) {
+	ctx := context.Background()
+	const (
+		projectID = "test"
+		datasetID = "dataset1"
+		tableID   = "users"
+	)
+
+	// Use dots for table names (BigQuery standard format)
+	tableName := projectID + "." + datasetID + "." + tableID
+
+	t.Log("=== Testing WaitForRowCount with BigQuery Emulator ===")
+
+	// Create BigQuery Emulator server
+	t.Log("1. Creating BigQuery Emulator server...")
+	bqServer, err := server.New(server.TempStorage)
+	if err != nil {
+		t.Fatalf("Failed to create BQE server: %v", err)
+	}
+
+	// Load initial data
+	t.Log("2. Loading initial project and dataset...")
+	if err := bqServer.Load(
+		server.StructSource(
+			types.NewProject(
+				projectID,
+				types.NewDataset(datasetID),
+			),
+		),
+	); err != nil {
+		t.Fatalf("Failed to load initial data: %v", err)
+	}
+
+	if err := bqServer.SetProject(projectID); err != nil {
+		t.Fatalf("Failed to set project: %v", err)
+	}
+
+	// Create test server
+	testServer := bqServer.TestServer()
+	defer testServer.Close()
+
+	// Create BigQuery client
+	t.Log("3. Creating BigQuery client...")
+	client, err := bigquery.NewClient(
+		ctx,
+		projectID,
+		option.WithEndpoint(testServer.URL),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create BigQuery client: %v", err)
+	}
+	defer client.Close()
+
+	// Create initial table
+	t.Log("4. Creating initial table...")
+	createTableSQL := `
+CREATE TABLE ` + "`" + tableName + "`" + ` (
+    id INT64,
+    name STRING
+)`
+	if err := Exec(ctx, client, createTableSQL); err != nil {
+		t.Fatalf("Failed to create table: %v", err)
+	}
+	t.Log("✓ Table created successfully")
+
+	// Stream rows through tabledata.insertAll
+	t.Log("5. Streaming rows via the Inserter...")
+	table := client.Dataset(datasetID).Table(tableID)
+	meta, err := table.Metadata(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get table metadata: %v", err)
+	}
+	rows := []*bigquery.ValuesSaver{
+		{Schema: meta.Schema, Row: []bigquery.Value{int64(1), "Alice"}},
+		{Schema: meta.Schema, Row: []bigquery.Value{int64(2), "Bob"}},
+		{Schema: meta.Schema, Row: []bigquery.Value{int64(3), "Charlie"}},
+	}
+	if err := table.Inserter().Put(ctx, rows); err != nil {
+		t.Fatalf("Failed to stream rows: %v", err)
+	}
+	t.Log("✓ Rows streamed successfully")
+
+	// Wait for the streamed rows to become queryable
+	t.Log("6. Waiting for streamed rows to become queryable...")
+	if err := WaitForRowCount(ctx, client, datasetID, tableID, 3, 5*time.Second); err != nil {
+		t.Fatalf("Streamed rows did not become queryable: %v", err)
+	}
+	t.Log("✓ All streamed rows are queryable")
+
+	// Wait for a count the table never reaches
+	t.Log("7. Waiting for an unreachable row count...")
+	err = WaitForRowCount(ctx, client, datasetID, tableID, 10, 200*time.Millisecond)
+	if err == nil {
+		t.Fatalf("WaitForRowCount should time out, but succeeded")
+	}
+	if !strings.Contains(err.Error(), "last observed count was 3") {
+		t.Fatalf("Timeout error %q does not report the last observed count", err)
+	}
+	t.Logf("✓ Timeout reported the last observed count (error: %v)", err)
+
+	// Wait on a table that does not exist, so no count is ever observed
+	t.Log("8. Waiting for rows in a missing table...")
+	err = WaitForRowCount(ctx, client, datasetID, "missing", 1, 200*time.Millisecond)
+	if err == nil {
+		t.Fatalf("WaitForRowCount on a missing table should time out, but succeeded")
+	}
+	if !strings.Contains(err.Error(), "no count observed") || strings.Contains(err.Error(), "-1") {
+		t.Fatalf("Timeout error %q does not report that no count was observed", err)
+	}
+	if errors.Unwrap(err) == nil {
+		t.Fatalf("Timeout error %q does not wrap the last query error", err)
+	}
+	t.Logf("✓ Timeout reported no count observed (error: %v)", err)
+
+	t.Log("=== WaitForRowCount test completed successfully! ===")
+}
@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestWildcardTableSuffix(t *testing.T) {
+	h := NewHarness(t)
+	wildcardName := h.TableName("events_*")
+
+	t.Log("=== Testing wildcard tables with _TABLE_SUFFIX with BigQuery Emulator ===")
+
+	// Create one table per day sharing the events_ prefix
+	t.Log("1. Creating date-sharded tables...")
+	for suffix, ids := range map[string]string{
+		"20240101": "(1), (2)",
+		"20240102": "(3)",
+	} {
+		tableName := h.TableName("events_" + suffix)
+		h.Exec(t, "CREATE TABLE "+tableName+" (id INT64)")
+		h.Exec(t, "INSERT INTO "+tableName+" (id) VALUES "+ids)
+	}
+	t.Log("✓ Tables events_20240101 and events_20240102 created")
+
+	for i, tc := range []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{
+			name: "suffix filter",
+			sql:  "SELECT id, _TABLE_SUFFIX FROM " + wildcardName + " WHERE _TABLE_SUFFIX = '20240102' ORDER BY id",
+			want: "[[3 20240102]]",
+		},
+		{
+			name: "full wildcard",
+			sql:  "SELECT id, _TABLE_SUFFIX FROM " + wildcardName + " ORDER BY id",
+			want: "[[1 20240101] [2 20240101] [3 20240102]]",
+		},
+	} {
+		t.Logf("%d. Querying with %s...", i+2, tc.name)
+		rows := h.Query(t, tc.sql)
+		if got := fmt.Sprint(rows); got != tc.want {
+			t.Fatalf("%s returned %s, want %s", tc.name, got, tc.want)
+		}
+		t.Logf("✓ %s returned %s", tc.name, tc.want)
+	}
+
+	t.Log("=== Wildcard table test completed successfully! ===")
+}
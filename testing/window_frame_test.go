@@ -0,0 +1,44 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestWindowFrames(t *testing.T) {
+	h := NewHarness(t)
+	tableName := h.TableName("payments")
+
+	t.Log("=== Testing window frames with BigQuery Emulator ===")
+
+	// The gap at ts = 3 makes ROWS and RANGE frames differ
+	t.Log("1. Creating and seeding table...")
+	h.Exec(t, "CREATE TABLE "+tableName+" (ts INT64, amount INT64)")
+	h.Exec(t, "INSERT INTO "+tableName+" (ts, amount) VALUES (1, 10), (2, 20), (4, 30), (5, 40), (6, 50)")
+	t.Log("✓ Table created and seeded")
+
+	for i, tc := range []struct {
+		frame string
+		want  string
+	}{
+		{frame: "ROWS BETWEEN 1 PRECEDING AND CURRENT ROW", want: "[10 30 50 70 90]"},
+		// At ts = 4 the value range [3, 4] holds only that row
+		{frame: "RANGE BETWEEN 1 PRECEDING AND CURRENT ROW", want: "[10 30 30 70 90]"},
+		{frame: "ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW", want: "[10 30 60 100 150]"},
+		{frame: "ROWS BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING", want: "[150 140 120 90 50]"},
+		{frame: "RANGE BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING", want: "[150 150 150 150 150]"},
+	} {
+		t.Logf("%d. SUM(amount) OVER (ORDER BY ts %s)...", i+2, tc.frame)
+		rows := h.Query(t, "SELECT SUM(amount) OVER (ORDER BY ts "+tc.frame+") FROM "+tableName+" ORDER BY ts")
+		var sums []any
+		for _, row := range rows {
+			sums = append(sums, row[0])
+		}
+		if got := fmt.Sprint(sums); got != tc.want {
+			t.Fatalf("Frame %s gave sums %s, want %s", tc.frame, got, tc.want)
+		}
+		t.Logf("✓ Sums are %s", tc.want)
+	}
+
+	t.Log("=== Window frame test completed successfully! ===")
+}